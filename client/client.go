@@ -3,6 +3,9 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 
@@ -306,6 +309,72 @@ func (cp *Proxy) Events(transactionID string, height int) ([]types.Event, error)
 	return ev, nil
 }
 
+// MaxEventsHeightRange is the max number of blocks whose events can be queried at once from an access node
+const MaxEventsHeightRange = 250
+
+// EventsOfType returns the events of the given type emitted inside the block at the given height.
+// Unlike Events, this includes the service events emitted by the system chunk transaction,
+// which is not part of any collection
+func (cp *Proxy) EventsOfType(eventType string, height int64) ([]types.Event, error) {
+	return cp.EventsOfTypeInRange(eventType, height, height)
+}
+
+// EventsOfTypes returns the events having one of the given types emitted inside the block at the given height,
+// sorted by the order in which they have been emitted. Access nodes only allow to query one event type at a time,
+// so the types are queried concurrently
+func (cp *Proxy) EventsOfTypes(eventTypes []string, height int64) ([]types.Event, error) {
+	results := make([][]types.Event, len(eventTypes))
+	errs := make([]error, len(eventTypes))
+
+	var wg sync.WaitGroup
+	for i, eventType := range eventTypes {
+		wg.Add(1)
+		go func(i int, eventType string) {
+			defer wg.Done()
+			results[i], errs[i] = cp.EventsOfType(eventType, height)
+		}(i, eventType)
+	}
+	wg.Wait()
+
+	var events []types.Event
+	for i, eventType := range eventTypes {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("error while getting %s events: %s", eventType, errs[i])
+		}
+		events = append(events, results[i]...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].TransactionIndex != events[j].TransactionIndex {
+			return events[i].TransactionIndex < events[j].TransactionIndex
+		}
+		return events[i].EventIndex < events[j].EventIndex
+	})
+	return events, nil
+}
+
+// EventsOfTypeInRange returns the events of the given type emitted inside the blocks between the given heights,
+// both included. Access nodes limit the number of blocks that can be queried at once, see MaxEventsHeightRange
+func (cp *Proxy) EventsOfTypeInRange(eventType string, startHeight int64, endHeight int64) ([]types.Event, error) {
+	blockEvents, err := cp.flowClient.GetEventsForHeightRange(cp.ctx, client.EventRangeQuery{
+		Type:        eventType,
+		StartHeight: uint64(startHeight),
+		EndHeight:   uint64(endHeight),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ev []types.Event
+	for _, block := range blockEvents {
+		for _, event := range block.Events {
+			ev = append(ev, types.NewEvent(int(block.Height), event.Type, event.TransactionID.String(),
+				event.TransactionIndex, event.EventIndex, event.Value))
+		}
+	}
+	return ev, nil
+}
+
 // Stop defers the node stop execution to the RPC client.
 func (cp *Proxy) Stop() {
 	err := cp.flowClient.Close()
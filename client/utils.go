@@ -2,12 +2,15 @@ package client
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/HarleyAppleChoi/junomum/types"
 
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // GetHeightRequestHeader returns the grpc.CallOption to query the state at a given height
@@ -18,6 +21,15 @@ func GetHeightRequestHeader(height int64) grpc.CallOption {
 	return grpc.Header(&header)
 }
 
+// IsNotFoundError tells whether the given error has been returned because the requested entity
+// does not exist. Older access nodes report missing accounts as internal errors, so the message is checked too
+func IsNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return status.Code(err) == codes.NotFound || strings.Contains(err.Error(), "not found")
+}
+
 // MustCreateGrpcConnection creates a new gRPC connection using the provided configuration and panics on error
 func MustCreateGrpcConnection(cfg types.Config) *grpc.ClientConn {
 	grpConnection, err := CreateGrpcConnection(cfg)
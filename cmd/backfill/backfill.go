@@ -0,0 +1,75 @@
+package backfill
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	parsecmd "github.com/HarleyAppleChoi/junomum/cmd/parse"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// BackfillCmd returns the command that should be run when we want to rebuild the data of some modules
+// using what has already been stored inside the database. If no module names are given,
+// all the enabled modules supporting the backfill are run
+func BackfillCmd(parseConfig *parsecmd.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:     "backfill [module]...",
+		Short:   "Rebuild the data of the given modules from the data already stored inside the database",
+		PreRunE: types.ConcatCobraCmdFuncs(parsecmd.ReadConfig(parseConfig)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parserData, err := parsecmd.SetupParsing(parseConfig)
+			if err != nil {
+				return err
+			}
+			defer parserData.Proxy.Stop()
+			defer parserData.Database.Close()
+
+			backfillModules, err := getBackfillModules(parserData.Modules, args)
+			if err != nil {
+				return err
+			}
+
+			for _, module := range backfillModules {
+				parserData.Logger.Info("backfilling module", "module", module.Name())
+				err = module.(modules.BackfillModule).Backfill()
+				if err != nil {
+					return fmt.Errorf("error while backfilling module %s: %s", module.Name(), err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// getBackfillModules returns the modules having the given names, or all the modules supporting
+// the backfill if no name is given. It returns an error if any of the given modules is not enabled
+// or does not support the backfill
+func getBackfillModules(enabled modules.Modules, names []string) (modules.Modules, error) {
+	if len(names) == 0 {
+		var backfillModules modules.Modules
+		for _, module := range enabled {
+			if _, ok := module.(modules.BackfillModule); ok {
+				backfillModules = append(backfillModules, module)
+			}
+		}
+		return backfillModules, nil
+	}
+
+	backfillModules := make(modules.Modules, len(names))
+	for i, name := range names {
+		module, found := enabled.FindByName(name)
+		if !found {
+			return nil, fmt.Errorf("module %s is not enabled", name)
+		}
+
+		if _, ok := module.(modules.BackfillModule); !ok {
+			return nil, fmt.Errorf("module %s does not support the backfill", name)
+		}
+
+		backfillModules[i] = module
+	}
+	return backfillModules, nil
+}
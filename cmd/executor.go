@@ -5,6 +5,7 @@ import (
 	"os"
 	"path"
 
+	backfillcmd "github.com/HarleyAppleChoi/junomum/cmd/backfill"
 	initcmd "github.com/HarleyAppleChoi/junomum/cmd/init"
 	parsecmd "github.com/HarleyAppleChoi/junomum/cmd/parse"
 
@@ -19,7 +20,7 @@ var (
 )
 
 // BuildDefaultExecutor allows to build an Executor containing a root command that
-// has the provided name and description and the default version and parse sub-commands implementations.
+// has the provided name and description and the default version, parse and backfill sub-commands implementations.
 //
 // registrar will be used to register custom modules. Be sure to provide an implementation that returns all
 // the modules that you want to use. If you don't want any custom module, use modules.EmptyRegistrar.
@@ -39,6 +40,7 @@ func BuildDefaultExecutor(config *Config) cli.Executor {
 		VersionCmd(),
 		initcmd.InitCmd(config.GetInitConfig()),
 		parsecmd.ParseCmd(config.GetParseConfig()),
+		backfillcmd.BackfillCmd(config.GetParseConfig()),
 	)
 
 	return PrepareRootCmd(config.GetName(), rootCmd)
@@ -32,5 +32,5 @@ func main() {
 }
 
 func getAddressesParser() messages.MessageAddressesParser {
-	return messages.DefaultMessagesParser
+	return messages.FlowMessageAddressesParser
 }
@@ -50,6 +50,12 @@ type Database interface {
 	SaveCollection(collection []types.Collection) error
 
 	SaveTransactionResult(txResults []types.TransactionResult, height uint64) error
+
+	// SaveAccountTransactions stores the relations between the accounts and the transactions involving them,
+	// along with the height of the latest transaction involving each account.
+	// An error is returned if the operation fails.
+	SaveAccountTransactions(relations []types.AccountTransaction) error
+
 	// Close closes the connection to the database
 	Close()
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/HarleyAppleChoi/junomum/logging"
 
@@ -122,27 +123,65 @@ func (db *Database) SaveTxs(txs types.Txs) error {
 	if len(txs) == 0 {
 		return nil
 	}
+
+	err := db.saveScripts(txs)
+	if err != nil {
+		return err
+	}
+
 	sqlStatement := `
 INSERT INTO transaction 
-    (height,transaction_id,script,arguments,reference_block_id,gas_limit,proposal_key ,payer,authorizers,payload_signature,envelope_signatures ) 
+    (height,transaction_id,script_hash,arguments,reference_block_id,gas_limit,proposal_key ,payer,authorizers,payload_signature,envelope_signatures ) 
 VALUES `
 
 	var vparams []interface{}
 	for i, tx := range txs {
+		arguments, err := tx.ArgumentsJSON()
+		if err != nil {
+			return fmt.Errorf("error while decoding arguments of transaction %s: %s", tx.TransactionID, err)
+		}
+
 		vi := i * 11
 		sqlStatement += fmt.Sprintf(`($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d),`,
 			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8, vi+9, vi+10, vi+11)
-		vparams = append(vparams, tx.Height, tx.TransactionID, tx.Script, pq.ByteaArray(tx.Arguments), tx.ReferenceBlockID, tx.GasLimit, tx.ProposalKey, tx.Payer, pq.StringArray(tx.Authorizers),
+		vparams = append(vparams, tx.Height, tx.TransactionID, tx.ScriptHash(), arguments, tx.ReferenceBlockID, tx.GasLimit, tx.ProposalKey, tx.Payer, pq.StringArray(tx.Authorizers),
 			tx.PayloadSignatures, tx.EnvelopeSignatures)
 
 	}
 	sqlStatement = sqlStatement[:len(sqlStatement)-1] // Remove trailing ,
 
 	sqlStatement += `ON CONFLICT DO NOTHING`
-	_, err := db.Sql.Exec(sqlStatement, vparams...)
+	_, err = db.Sql.Exec(sqlStatement, vparams...)
 	return err
 }
 
+// saveScripts stores the distinct scripts of the given transactions inside the script table
+func (db *Database) saveScripts(txs types.Txs) error {
+	stmt := `INSERT INTO script (hash,script) VALUES `
+
+	var params []interface{}
+	saved := make(map[string]bool)
+	for _, tx := range txs {
+		hash := tx.ScriptHash()
+		if saved[hash] {
+			continue
+		}
+
+		si := len(saved) * 2
+		stmt += fmt.Sprintf("($%d, $%d),", si+1, si+2)
+		params = append(params, hash, string(tx.Script))
+		saved[hash] = true
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ,
+	stmt += " ON CONFLICT DO NOTHING"
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing scripts: %s", err)
+	}
+	return nil
+}
+
 // HasValidator implements db.Database
 func (db *Database) HasValidator(addr string) (bool, error) {
 	var res bool
@@ -278,22 +317,33 @@ WHERE message.transaction_hash = transaction.hash AND transaction.height = $1
 	return err
 }
 
+// SaveEvents implements db.Database
 func (db *Database) SaveEvents(events []types.Event) error {
 	if len(events) == 0 {
 		return nil
 	}
 
 	stmt := `INSERT INTO event (
-		height,type,transaction_id,transaction_index,event_index,value
+		height,type,transaction_id,transaction_index,event_index,value,fields
 	) VALUES `
 
 	var vparams []interface{}
 	for i, event := range events {
-		vi := i * 6
+		vi := i * 7
+
+		value, err := event.JSON()
+		if err != nil {
+			return fmt.Errorf("error while encoding event %s: %s", event.Type, err)
+		}
 
-		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),",
-			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6)
-		vparams = append(vparams, event.Height, event.Type, event.TransactionID, event.TransactionIndex, event.EventIndex, event.Value.String())
+		fields, err := event.FieldsJSON()
+		if err != nil {
+			return fmt.Errorf("error while flattening event %s fields: %s", event.Type, err)
+		}
+
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d),",
+			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7)
+		vparams = append(vparams, event.Height, event.Type, event.TransactionID, event.TransactionIndex, event.EventIndex, value, fields)
 	}
 
 	stmt = stmt[:len(stmt)-1] // Remove trailing ,
@@ -348,3 +398,66 @@ func (db *Database) SaveTransactionResult(transactionResult []types.TransactionR
 
 	return nil
 }
+
+// SaveAccountTransactions implements db.Database
+func (db *Database) SaveAccountTransactions(relations []types.AccountTransaction) error {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account_transaction(address,transaction_id,height,role) VALUES `
+
+	var params []interface{}
+	for i, relation := range relations {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params, relation.Address, relation.TransactionID, relation.Height, relation.Role)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving account transactions: %s", err)
+	}
+
+	return db.saveAccountsLastActiveHeight(relations)
+}
+
+// saveAccountsLastActiveHeight stores, for each account involved in the given relations,
+// the height of the most recent transaction it is involved in
+func (db *Database) saveAccountsLastActiveHeight(relations []types.AccountTransaction) error {
+	heights := make(map[string]uint64)
+	for _, relation := range relations {
+		if relation.Height > heights[relation.Address] {
+			heights[relation.Address] = relation.Height
+		}
+	}
+
+	// Sort the addresses so that concurrent upserts lock the rows in the same order
+	addresses := make([]string, 0, len(heights))
+	for address := range heights {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	stmt := `INSERT INTO account(address,last_active_height) VALUES `
+
+	var params []interface{}
+	for i, address := range addresses {
+		ai := i * 2
+		stmt += fmt.Sprintf("($%d,$%d),", ai+1, ai+2)
+		params = append(params, address, heights[address])
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (address) DO UPDATE
+	SET last_active_height = excluded.last_active_height
+	WHERE account.last_active_height < excluded.last_active_height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving accounts last active height: %s", err)
+	}
+
+	return nil
+}
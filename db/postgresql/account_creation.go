@@ -0,0 +1,68 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveAccountCreations stores the given account creations, storing the created accounts as well
+func (db *Db) SaveAccountCreations(creations []types.AccountCreation) error {
+	if len(creations) == 0 {
+		return nil
+	}
+
+	accountStmt := `INSERT INTO account(address) VALUES `
+	creationStmt := `INSERT INTO account_creation(address,creator,transaction_id,height) VALUES `
+
+	var accountParams []interface{}
+	var creationParams []interface{}
+	for i, creation := range creations {
+		accountStmt += fmt.Sprintf("($%d),", i+1)
+		accountParams = append(accountParams, creation.Address)
+
+		ai := i * 4
+		creationStmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		creationParams = append(creationParams,
+			creation.Address,
+			nullString(creation.Creator),
+			creation.TransactionID,
+			creation.Height,
+		)
+	}
+
+	accountStmt = accountStmt[:len(accountStmt)-1]
+	accountStmt += ` ON CONFLICT DO NOTHING`
+	_, err := db.Sqlx.Exec(accountStmt, accountParams...)
+	if err != nil {
+		return fmt.Errorf("fail to insert into account: %s", err)
+	}
+
+	creationStmt = creationStmt[:len(creationStmt)-1]
+	creationStmt += ` ON CONFLICT (address) DO NOTHING`
+	_, err = db.Sqlx.Exec(creationStmt, creationParams...)
+	if err != nil {
+		return fmt.Errorf("error while saving account creations: %s", err)
+	}
+
+	return nil
+}
+
+// GetTransactionPayers returns the payer of each of the given transactions, indexed by transaction id
+func (db *Db) GetTransactionPayers(transactionIDs []string) (map[string]string, error) {
+	var rows []dbtypes.TransactionPayerRow
+	err := db.Sqlx.Select(&rows, `SELECT transaction_id, payer FROM transaction WHERE transaction_id = ANY($1)`,
+		pq.Array(transactionIDs))
+	if err != nil {
+		return nil, fmt.Errorf("error while getting transaction payers: %s", err)
+	}
+
+	payers := make(map[string]string, len(rows))
+	for _, row := range rows {
+		payers[row.TransactionID] = row.Payer.String
+	}
+	return payers, nil
+}
@@ -0,0 +1,59 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountCreations() {
+	creations := []types.AccountCreation{
+		types.NewAccountCreation("0000000000000002", "0000000000000001", "0x6", 10),
+		types.NewAccountCreation("0000000000000003", "", "0x7", 11),
+	}
+
+	err := suite.database.SaveAccountCreations(creations)
+	suite.Require().NoError(err)
+
+	// Saving the same creations twice should not fail
+	err = suite.database.SaveAccountCreations(creations)
+	suite.Require().NoError(err)
+
+	var accounts []dbtypes.AccountRow
+	err = suite.database.Sqlx.Select(&accounts, `SELECT address FROM account ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 2)
+
+	expected := []dbtypes.AccountCreationRow{
+		dbtypes.NewAccountCreationRow("0000000000000002", "0000000000000001", "0x6", 10),
+		dbtypes.NewAccountCreationRow("0000000000000003", "", "0x7", 11),
+	}
+
+	var rows []dbtypes.AccountCreationRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_creation ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetTransactionPayers() {
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveTxs(types.Txs{
+		types.NewTx(block.Height, txID.String(), []byte("transaction {}"), nil, "0x2", 100, "0x1", "0000000000000001",
+			[]string{"0000000000000001"}, []byte("[]"), []byte("[]")),
+	})
+	suite.Require().NoError(err)
+
+	payers, err := suite.database.GetTransactionPayers([]string{txID.String(), "0x7"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]string{txID.String(): "0000000000000001"}, payers)
+}
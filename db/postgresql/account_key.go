@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveAccountKeyHistory stores the given key changes observed through the account key events.
+// Changes that are already known through an event are never replaced, while the ones
+// observed by a periodic refresh are replaced by the events
+func (db *Db) SaveAccountKeyHistory(keys []types.AccountKeyHistory) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO account_key_history(address,index,public_key,sig_algo,hash_algo,weight,
+	added_height,added_transaction_id,revoked_height,revoked_transaction_id) VALUES `
+
+	var params []interface{}
+	for i, key := range keys {
+		ai := i * 10
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
+			ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8, ai+9, ai+10)
+
+		params = append(params,
+			key.Address,
+			key.Index,
+			key.PublicKey,
+			key.SigAlgo,
+			key.HashAlgo,
+			key.Weight,
+			sql.NullInt64{Int64: int64(key.AddedHeight), Valid: key.AddedHeight != 0},
+			nullString(key.AddedTransactionID),
+			sql.NullInt64{Int64: int64(key.RevokedHeight), Valid: key.RevokedHeight != 0},
+			nullString(key.RevokedTransactionID),
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address, index) DO UPDATE
+	SET added_height = CASE WHEN account_key_history.added_transaction_id IS NULL AND excluded.added_transaction_id IS NOT NULL
+	                        THEN excluded.added_height ELSE account_key_history.added_height END,
+	    added_transaction_id = COALESCE(account_key_history.added_transaction_id, excluded.added_transaction_id),
+	    revoked_height = CASE WHEN account_key_history.revoked_transaction_id IS NULL AND excluded.revoked_transaction_id IS NOT NULL
+	                          THEN excluded.revoked_height ELSE account_key_history.revoked_height END,
+	    revoked_transaction_id = COALESCE(account_key_history.revoked_transaction_id, excluded.revoked_transaction_id)`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving account key history: %s", err)
+	}
+
+	return nil
+}
+
+// GetRecordedAccountKeyIndexes returns the indexes of the keys of the given account whose addition, or revocation
+// if removed is true, has already been stored through an event emitted by a transaction other than the given one
+func (db *Db) GetRecordedAccountKeyIndexes(address string, removed bool, transactionID string) ([]int, error) {
+	column := "added_transaction_id"
+	if removed {
+		column = "revoked_transaction_id"
+	}
+
+	stmt := fmt.Sprintf(`
+SELECT index FROM account_key_history
+WHERE address = $1 AND %[1]s IS NOT NULL AND %[1]s <> $2
+ORDER BY index`, column)
+
+	var indexes []int
+	err := db.Sqlx.Select(&indexes, stmt, address, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting recorded account keys: %s", err)
+	}
+
+	return indexes, nil
+}
+
+// SyncAccountKeyHistory updates the account key history using the current keys stored inside the
+// account_key_list table by the periodic accounts refreshes. Since the heights at which keys and revocations
+// that are not yet known happened cannot be told, these are left empty and the given height is stored
+// as the one at which they have been observed instead
+func (db *Db) SyncAccountKeyHistory(height int64) error {
+	stmt := `
+INSERT INTO account_key_history(address,index,public_key,sig_algo,hash_algo,weight,added_observed_height)
+SELECT address, index, public_key, sig_algo, hash_algo, weight::BIGINT, $1 FROM account_key_list
+ON CONFLICT (address, index) DO NOTHING`
+	_, err := db.Sqlx.Exec(stmt, height)
+	if err != nil {
+		return fmt.Errorf("error while syncing added account keys: %s", err)
+	}
+
+	stmt = `
+UPDATE account_key_history SET revoked_observed_height = $1
+FROM account_key_list
+WHERE account_key_list.address = account_key_history.address
+  AND account_key_list.index = account_key_history.index
+  AND account_key_list.revoked
+  AND account_key_history.revoked_height IS NULL
+  AND account_key_history.revoked_observed_height IS NULL`
+	_, err = db.Sqlx.Exec(stmt, height)
+	if err != nil {
+		return fmt.Errorf("error while syncing revoked account keys: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountKeyHistory() {
+	// The removal can be handled before the addition, since blocks are parsed in parallel
+	err := suite.database.SaveAccountKeyHistory([]types.AccountKeyHistory{
+		types.NewAccountKeyHistory("0000000000000001", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 0, "", 20, "0x7"),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountKeyHistory([]types.AccountKeyHistory{
+		types.NewAccountKeyHistory("0000000000000001", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 0, ""),
+		types.NewAccountKeyHistory("0000000000000002", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 0, ""),
+	})
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.AccountKeyHistoryRow{
+		dbtypes.NewAccountKeyHistoryRow("0000000000000001", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 20, "0x7", 0, 0),
+		dbtypes.NewAccountKeyHistoryRow("0000000000000002", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 0, "", 0, 0),
+	}
+
+	var rows []dbtypes.AccountKeyHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_key_history ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetRecordedAccountKeyIndexes() {
+	err := suite.database.SaveAccountKeyHistory([]types.AccountKeyHistory{
+		types.NewAccountKeyHistory("0000000000000001", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 20, "0x7"),
+		types.NewAccountKeyHistory("0000000000000001", 1, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 30, "0x8"),
+		types.NewAccountKeyHistory("0000000000000001", 2, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "", 0, ""),
+		types.NewAccountKeyHistory("0000000000000002", 0, "0xabcd", "ECDSA_P256", "SHA3_256", 1000, 10, "0x6", 20, "0x7"),
+	})
+	suite.Require().NoError(err)
+
+	indexes, err := suite.database.GetRecordedAccountKeyIndexes("0000000000000001", false, "0x9")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int{0, 1}, indexes)
+
+	// Changes recorded by the given transaction should not be returned, so that it can be handled again
+	indexes, err = suite.database.GetRecordedAccountKeyIndexes("0000000000000001", true, "0x8")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int{0}, indexes)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SyncAccountKeyHistory() {
+	pubkeyString := "d0d45a9f40dc5e7440c71fcbc1a7836e7b38cc7874ac2875c5475fe550f582e005a5ed864c779d413fe49f58d3451c24ccf2cc12b9495c2baeeb0752538a0bcb"
+	pubkey, err := crypto.DecodePublicKeyHex(crypto.ECDSA_P256, pubkeyString)
+	suite.Require().NoError(err)
+
+	// Two accounts having the same key index
+	var accounts []types.Account
+	for _, address := range []string{"0x1", "0x2"} {
+		account, err := types.NewAccount(flow.Account{
+			Address:   flow.HexToAddress(address),
+			Keys:      []*flow.AccountKey{flow.NewAccountKey().SetWeight(1000).SetSigAlgo(crypto.ECDSA_P256).SetHashAlgo(crypto.SHA2_256).SetPublicKey(pubkey)},
+			Contracts: map[string][]byte{},
+		})
+		suite.Require().NoError(err)
+		accounts = append(accounts, account)
+	}
+
+	err = suite.database.SaveAccounts(accounts, 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SyncAccountKeyHistory(10)
+	suite.Require().NoError(err)
+
+	// Revoke the key of the first account
+	accounts[0].Keys[0].Revoked = true
+	err = suite.database.SaveAccounts(accounts[:1], 11)
+	suite.Require().NoError(err)
+
+	err = suite.database.SyncAccountKeyHistory(11)
+	suite.Require().NoError(err)
+
+	// Events received later complete the history
+	err = suite.database.SaveAccountKeyHistory([]types.AccountKeyHistory{
+		types.NewAccountKeyHistory("0000000000000002", 0, "0x"+pubkeyString, "ECDSA_P256", "SHA2_256", 1000, 0, "", 12, "0x8"),
+	})
+	suite.Require().NoError(err)
+
+	// The heights of the changes are unknown, so only the ones at which they have been observed are stored
+	expected := []dbtypes.AccountKeyHistoryRow{
+		dbtypes.NewAccountKeyHistoryRow("0000000000000001", 0, "0x"+pubkeyString, "ECDSA_P256", "SHA2_256", 1000, 0, "", 0, "", 10, 11),
+		dbtypes.NewAccountKeyHistoryRow("0000000000000002", 0, "0x"+pubkeyString, "ECDSA_P256", "SHA2_256", 1000, 0, "", 12, "0x8", 10, 0),
+	}
+
+	var rows []dbtypes.AccountKeyHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_key_history ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
@@ -0,0 +1,78 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// GetAccountsToRefresh returns at most limit stored accounts, most recently active first.
+// If a cursor is given, only the accounts coming after it in that order are returned
+func (db *Db) GetAccountsToRefresh(cursor *types.AccountActivity, limit int) ([]types.AccountActivity, error) {
+	stmt := `SELECT address, last_active_height FROM account`
+
+	params := []interface{}{limit}
+	if cursor != nil {
+		stmt += ` WHERE (last_active_height, address) < ($2, $3)`
+		params = append(params, cursor.LastActiveHeight, cursor.Address)
+	}
+
+	stmt += ` ORDER BY last_active_height DESC, address DESC LIMIT $1`
+
+	var rows []dbtypes.AccountActivityRow
+	err := db.Sqlx.Select(&rows, stmt, params...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting accounts to refresh: %s", err)
+	}
+
+	accounts := make([]types.AccountActivity, len(rows))
+	for i, row := range rows {
+		accounts[i] = types.NewAccountActivity(row.Address, row.LastActiveHeight)
+	}
+
+	return accounts, nil
+}
+
+// GetAccountRefreshCursor returns the last account refreshed by the running refresh of all the accounts,
+// or nil if no refresh is running
+func (db *Db) GetAccountRefreshCursor() (*types.AccountActivity, error) {
+	var row dbtypes.AccountActivityRow
+	err := db.Sqlx.Get(&row, `SELECT address, last_active_height FROM account_refresh_cursor`)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account refresh cursor: %s", err)
+	}
+
+	cursor := types.NewAccountActivity(row.Address, row.LastActiveHeight)
+	return &cursor, nil
+}
+
+// SaveAccountRefreshCursor stores the last account refreshed by the running refresh of all the accounts
+func (db *Db) SaveAccountRefreshCursor(cursor types.AccountActivity) error {
+	stmt := `
+INSERT INTO account_refresh_cursor (address, last_active_height) VALUES ($1, $2)
+ON CONFLICT (one_row_id) DO UPDATE
+	SET address = excluded.address,
+	    last_active_height = excluded.last_active_height`
+
+	_, err := db.Sqlx.Exec(stmt, cursor.Address, cursor.LastActiveHeight)
+	if err != nil {
+		return fmt.Errorf("error while saving account refresh cursor: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteAccountRefreshCursor removes the account refresh cursor, so that the next refresh starts from the beginning
+func (db *Db) DeleteAccountRefreshCursor() error {
+	_, err := db.Sqlx.Exec(`DELETE FROM account_refresh_cursor`)
+	if err != nil {
+		return fmt.Errorf("error while deleting account refresh cursor: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_GetAccountsToRefresh() {
+	suite.Require().NoError(suite.AddAccount("0x1"))
+	suite.Require().NoError(suite.AddAccount("0x2"))
+	suite.Require().NoError(suite.AddAccount("0x3"))
+
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountTransactions([]types.AccountTransaction{
+		types.NewAccountTransaction("0x1", txID.String(), block.Height, types.AccountTransactionRolePayer),
+	})
+	suite.Require().NoError(err)
+
+	// The most recently active account comes first, then the others by address
+	accounts, err := suite.database.GetAccountsToRefresh(nil, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AccountActivity{
+		types.NewAccountActivity("0x1", 10),
+		types.NewAccountActivity("0x3", 0),
+	}, accounts)
+
+	accounts, err = suite.database.GetAccountsToRefresh(&accounts[1], 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AccountActivity{types.NewAccountActivity("0x2", 0)}, accounts)
+
+	accounts, err = suite.database.GetAccountsToRefresh(&accounts[0], 2)
+	suite.Require().NoError(err)
+	suite.Require().Empty(accounts)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountTransactions_LastActiveHeight() {
+	suite.Require().NoError(suite.AddAccount("0x1"))
+
+	suite.getBlock(10)
+	err := suite.database.SaveBlock(&flow.Block{
+		BlockHeader: flow.BlockHeader{ID: flow.HexToID("0xa"), ParentID: flow.HexToID("0x1"), Height: 20},
+	})
+	suite.Require().NoError(err)
+
+	txIDs := []flow.Identifier{flow.HexToID("0x6"), flow.HexToID("0x7")}
+	err = suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(10, "0x3", true, txIDs[:1]),
+		types.NewCollection(20, "0x4", true, txIDs[1:]),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountTransactions([]types.AccountTransaction{
+		types.NewAccountTransaction("0x1", txIDs[1].String(), 20, types.AccountTransactionRolePayer),
+		types.NewAccountTransaction("0x1", txIDs[1].String(), 20, types.AccountTransactionRoleProposer),
+		types.NewAccountTransaction("0x2", txIDs[1].String(), 20, types.AccountTransactionRoleAuthorizer),
+	})
+	suite.Require().NoError(err)
+
+	// An older transaction should not lower the last active height
+	err = suite.database.SaveAccountTransactions([]types.AccountTransaction{
+		types.NewAccountTransaction("0x1", txIDs[0].String(), 10, types.AccountTransactionRolePayer),
+		types.NewAccountTransaction("0x3", txIDs[0].String(), 10, types.AccountTransactionRolePayer),
+	})
+	suite.Require().NoError(err)
+
+	// Accounts not stored yet are added along with their activity
+	accounts, err := suite.database.GetAccountsToRefresh(nil, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AccountActivity{
+		types.NewAccountActivity("0x2", 20),
+		types.NewAccountActivity("0x1", 20),
+		types.NewAccountActivity("0x3", 10),
+	}, accounts)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_AccountRefreshCursor() {
+	cursor, err := suite.database.GetAccountRefreshCursor()
+	suite.Require().NoError(err)
+	suite.Require().Nil(cursor)
+
+	suite.Require().NoError(suite.database.SaveAccountRefreshCursor(types.NewAccountActivity("0x1", 10)))
+	suite.Require().NoError(suite.database.SaveAccountRefreshCursor(types.NewAccountActivity("0x2", 5)))
+
+	cursor, err = suite.database.GetAccountRefreshCursor()
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.AccountActivity{Address: "0x2", LastActiveHeight: 5}, cursor)
+
+	suite.Require().NoError(suite.database.DeleteAccountRefreshCursor())
+
+	cursor, err = suite.database.GetAccountRefreshCursor()
+	suite.Require().NoError(err)
+	suite.Require().Nil(cursor)
+}
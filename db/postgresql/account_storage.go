@@ -0,0 +1,71 @@
+package postgresql
+
+import (
+	"fmt"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveAccountStorage stores the given storage usages inside the account_storage history
+func (db *Db) SaveAccountStorage(storages []types.AccountStorage) error {
+	if len(storages) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account_storage(address,used,capacity,height) VALUES `
+
+	var params []interface{}
+	for i, storage := range storages {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params, storage.Address, storage.Used, storage.Capacity, storage.Height)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address, height) DO UPDATE
+	SET used = excluded.used,
+	    capacity = excluded.capacity`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving account storage: %s", err)
+	}
+
+	addresses := make([]string, len(storages))
+	for i, storage := range storages {
+		addresses[i] = storage.Address
+	}
+
+	err = db.pruneHistory("account_storage", []string{"address"}, "address", addresses)
+	if err != nil {
+		return fmt.Errorf("error while pruning account storage: %s", err)
+	}
+
+	return nil
+}
+
+// GetAccountsNearStorageCapacity returns the latest storage usage of the accounts using at least
+// the given ratio (between 0 and 1) of their storage capacity, the fullest accounts first
+func (db *Db) GetAccountsNearStorageCapacity(ratio float64) ([]types.AccountStorage, error) {
+	stmt := `
+SELECT address, used, capacity, height
+FROM (SELECT DISTINCT ON (address) address, used, capacity, height
+      FROM account_storage
+      ORDER BY address, height DESC) AS latest
+WHERE capacity > 0 AND used >= capacity * $1::FLOAT8
+ORDER BY used::NUMERIC / capacity DESC, address`
+
+	var rows []dbtypes.AccountStorageRow
+	err := db.Sqlx.Select(&rows, stmt, ratio)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting accounts near storage capacity: %s", err)
+	}
+
+	storages := make([]types.AccountStorage, len(rows))
+	for i, row := range rows {
+		storages[i] = types.NewAccountStorage(row.Address, row.Used, row.Capacity, row.Height)
+	}
+
+	return storages, nil
+}
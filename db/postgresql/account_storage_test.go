@@ -0,0 +1,93 @@
+package postgresql_test
+
+import (
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountStorage() {
+	suite.Require().NoError(suite.AddAccount("0x1"))
+
+	err := suite.database.SaveAccountStorage([]types.AccountStorage{
+		types.NewAccountStorage("0x1", 100, 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the same height twice should update the values
+	err = suite.database.SaveAccountStorage([]types.AccountStorage{
+		types.NewAccountStorage("0x1", 200, 1000, 10),
+		types.NewAccountStorage("0x1", 300, 1000, 11),
+	})
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.AccountStorageRow{
+		dbtypes.NewAccountStorageRow("0x1", 200, 1000, 10),
+		dbtypes.NewAccountStorageRow("0x1", 300, 1000, 11),
+	}
+
+	var rows []dbtypes.AccountStorageRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_storage ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountStorage_WithoutHistory() {
+	suite.Require().NoError(suite.AddAccount("0x1"))
+	suite.Require().NoError(suite.AddAccount("0x2"))
+	db := suite.buildDatabase(false)
+
+	err := db.SaveAccountStorage([]types.AccountStorage{
+		types.NewAccountStorage("0x1", 100, 1000, 10),
+		types.NewAccountStorage("0x2", 100, 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = db.SaveAccountStorage([]types.AccountStorage{
+		types.NewAccountStorage("0x1", 300, 1000, 11),
+	})
+	suite.Require().NoError(err)
+
+	// Only the latest storage of each account should be kept
+	expected := []dbtypes.AccountStorageRow{
+		dbtypes.NewAccountStorageRow("0x1", 300, 1000, 11),
+		dbtypes.NewAccountStorageRow("0x2", 100, 1000, 10),
+	}
+
+	var rows []dbtypes.AccountStorageRow
+	err = db.Sqlx.Select(&rows, `SELECT * FROM account_storage ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetAccountsNearStorageCapacity() {
+	suite.Require().NoError(suite.AddAccount("0x1"))
+	suite.Require().NoError(suite.AddAccount("0x2"))
+	suite.Require().NoError(suite.AddAccount("0x3"))
+
+	err := suite.database.SaveAccountStorage([]types.AccountStorage{
+		// 0x1 was near capacity, but its capacity has been increased since then
+		types.NewAccountStorage("0x1", 950, 1000, 10),
+		types.NewAccountStorage("0x1", 950, 2000, 11),
+		types.NewAccountStorage("0x2", 900, 1000, 10),
+		types.NewAccountStorage("0x3", 990, 1000, 12),
+	})
+	suite.Require().NoError(err)
+
+	storages, err := suite.database.GetAccountsNearStorageCapacity(0.9)
+	suite.Require().NoError(err)
+
+	expected := []types.AccountStorage{
+		types.NewAccountStorage("0x3", 990, 1000, 12),
+		types.NewAccountStorage("0x2", 900, 1000, 10),
+	}
+	suite.Require().Len(storages, len(expected))
+	for i, storage := range storages {
+		suite.Require().True(storage.Equal(expected[i]))
+	}
+}
@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveTransactionActions stores the given actions recognised from known transaction templates
+func (db *Db) SaveTransactionActions(actions []types.TransactionAction) error {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO transaction_action(transaction_id,height,action,"from","to",amount,node_id) VALUES `
+
+	var params []interface{}
+	for i, action := range actions {
+		ai := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7)
+
+		params = append(params,
+			action.TransactionID,
+			action.Height,
+			action.Action,
+			nullString(action.From),
+			nullString(action.To),
+			sql.NullInt64{Int64: int64(action.Amount), Valid: action.Amount != 0},
+			nullString(action.NodeID),
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (transaction_id) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving transaction actions: %s", err)
+	}
+
+	return nil
+}
+
+// nullString returns a sql.NullString that is NULL when the given value is empty
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// nullUint64 returns the value pointed by the given pointer, or nil if it does not point to anything
+func nullUint64(value *uint64) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
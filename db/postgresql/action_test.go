@@ -0,0 +1,48 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTransactionActions() {
+	block := suite.getBlock(10)
+	txIDs := []flow.Identifier{flow.HexToID("0x6"), flow.HexToID("0x7")}
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, txIDs),
+	})
+	suite.Require().NoError(err)
+
+	actions := []types.TransactionAction{
+		types.NewTransactionAction(txIDs[0].String(), block.Height, "transfer_flow",
+			"8d0e87b65159ae63", "1654653399040a61", 100000000, ""),
+		types.NewTransactionAction(txIDs[1].String(), block.Height, "stake_new_tokens",
+			"8d0e87b65159ae63", "", 200000000, "node-id"),
+	}
+
+	// Save the data
+	err = suite.database.SaveTransactionActions(actions)
+	suite.Require().NoError(err)
+
+	// Saving the same actions twice should not fail
+	err = suite.database.SaveTransactionActions(actions)
+	suite.Require().NoError(err)
+
+	// Verify the data
+	expected := []dbtypes.TransactionActionRow{
+		dbtypes.NewTransactionActionRow(txIDs[0].String(), block.Height, "transfer_flow",
+			"8d0e87b65159ae63", "1654653399040a61", 100000000, ""),
+		dbtypes.NewTransactionActionRow(txIDs[1].String(), block.Height, "stake_new_tokens",
+			"8d0e87b65159ae63", "", 200000000, "node-id"),
+	}
+
+	var rows []dbtypes.TransactionActionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM transaction_action ORDER BY transaction_id`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
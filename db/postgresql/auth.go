@@ -2,6 +2,9 @@ package postgresql
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
 
 	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
 	"github.com/HarleyAppleChoi/junomum/db/utils"
@@ -62,12 +65,33 @@ func (db *Db) saveAccounts(accounts []types.Account, height uint64) error {
 		params2 = append(params2, account.Address, account.Balance, account.Code, account.Contracts, height)
 	}
 	stmt = stmt[:len(stmt)-1]
-	stmt += " ON CONFLICT (address) DO NOTHING "
+	stmt += `
+ON CONFLICT (address) DO UPDATE
+	SET balance = excluded.balance,
+	    code = excluded.code,
+	    contract_map = excluded.contract_map,
+	    height = excluded.height
+WHERE account_balance.height <= excluded.height`
 	_, err = db.Sqlx.Exec(stmt, params2...)
 	if err != nil {
 		return fmt.Errorf("fail to insert into account_balance: %s", err)
 	}
 
+	err = db.saveAccountBalanceHistory(accounts, height)
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = account.Address
+	}
+
+	err = db.pruneHistory("account_balance_history", []string{"address"}, "address", addresses)
+	if err != nil {
+		return fmt.Errorf("fail to prune account_balance_history: %s", err)
+	}
+
 	var params3 []interface{}
 
 	for _, rows := range accounts {
@@ -83,7 +107,11 @@ func (db *Db) saveAccounts(accounts []types.Account, height uint64) error {
 			}
 
 			stmt = stmt[:len(stmt)-1]
-			stmt += ` ON CONFLICT DO NOTHING`
+			stmt += `
+ON CONFLICT (address, index) DO UPDATE
+	SET weight = excluded.weight,
+	    revoked = excluded.revoked,
+	    sequence_number = excluded.sequence_number`
 
 			_, err = db.Sqlx.Exec(stmt, params3...)
 			if err != nil {
@@ -91,11 +119,92 @@ func (db *Db) saveAccounts(accounts []types.Account, height uint64) error {
 			}
 			params3 = make([]interface{}, 0)
 		}
+
+		for _, keyList := range utils.SplitAccountKeyList(rows.Keys, 9) {
+			err = db.saveAccountKeyListHistory(rows.Address, keyList, height)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = db.pruneHistory("account_key_list_history", []string{"address", "index"}, "address", addresses)
+	if err != nil {
+		return fmt.Errorf("fail to prune account_key_list_history: %s", err)
+	}
+
+	return nil
+}
+
+// saveAccountBalanceHistory stores the balances of the given accounts inside the account_balance_history table
+func (db *Db) saveAccountBalanceHistory(accounts []types.Account, height uint64) error {
+	stmt := `INSERT INTO account_balance_history (address,balance,code,contract_map,height) VALUES `
+
+	var params []interface{}
+	for i, account := range accounts {
+		ai := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5)
+		params = append(params, account.Address, account.Balance, account.Code, account.Contracts, height)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (address, height) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("fail to insert into account_balance_history: %s", err)
+	}
+
+	return nil
+}
+
+// saveAccountKeyListHistory stores the given keys of the account with the given address inside
+// the account_key_list_history table
+func (db *Db) saveAccountKeyListHistory(address string, keyList []types.AccountKeyList, height uint64) error {
+	if len(keyList) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account_key_list_history(address,index,weight,revoked,sig_algo,hash_algo,public_key,sequence_number,height) VALUES `
+
+	var params []interface{}
+	for i, accountKey := range keyList {
+		ai := i * 9
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8, ai+9)
+		params = append(params, address, accountKey.Index, accountKey.Weight, accountKey.Revoked, accountKey.SigAlgo, accountKey.HashAlgo, accountKey.PublicKey, accountKey.SequenceNumber, height)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (address, index, height) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("fail to insert into account_key_list_history: %s", err)
 	}
 
 	return nil
 }
 
+// pruneHistory removes from the given history table all the rows of the given addresses that are older than
+// the latest one stored for the same key columns. This is done only when historical data should not be stored,
+// so that only the latest values are kept
+func (db *Db) pruneHistory(table string, keyColumns []string, addressColumn string, addresses []string) error {
+	if db.storeHistoricalData || len(addresses) == 0 {
+		return nil
+	}
+
+	var conditions []string
+	for _, column := range keyColumns {
+		conditions = append(conditions, fmt.Sprintf("older.%[1]s = newer.%[1]s", pq.QuoteIdentifier(column)))
+	}
+
+	stmt := fmt.Sprintf(`
+DELETE FROM %[1]s AS older USING %[1]s AS newer
+WHERE %[2]s AND older.height < newer.height AND older.%[3]s = ANY($1)`,
+		pq.QuoteIdentifier(table), strings.Join(conditions, " AND "), pq.QuoteIdentifier(addressColumn))
+
+	_, err := db.Sqlx.Exec(stmt, pq.Array(addresses))
+	return err
+}
+
 func (db *Db) SaveLockedAccount(accounts []types.LockedAccount) error {
 	stmt := `INSERT INTO locked_account(address,locked_address) VALUES `
 
@@ -140,6 +249,16 @@ func (db *Db) SaveLockedAccountBalance(accounts []types.LockedAccountBalance) er
 	if err != nil {
 		return fmt.Errorf("psql error on locked_account_balance: %s", err)
 	}
+
+	lockedAddresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		lockedAddresses[i] = account.LockedAddress
+	}
+
+	err = db.pruneHistory("locked_account_balance", []string{"locked_address"}, "locked_address", lockedAddresses)
+	if err != nil {
+		return fmt.Errorf("psql error while pruning locked_account_balance: %s", err)
+	}
 	return nil
 }
 
@@ -151,7 +270,7 @@ func (db *Db) SaveDelegatorAccounts(accounts []types.DelegatorAccount) error {
 	var params []interface{}
 
 	for i, account := range accounts {
-		ai := i * 4
+		ai := i * 3
 		stmt += fmt.Sprintf("($%d,$%d,$%d),", ai+1, ai+2, ai+3)
 
 		params = append(params, account.Address, account.DelegatorId, account.DelegatorNodeId)
@@ -184,6 +303,21 @@ func (db *Db) GetAddresses() ([]string, error) {
 }
 
 func (db *Db) SaveStakerNodeId(stakerNodeId []types.StakerNodeId) error {
+	if len(stakerNodeId) == 0 {
+		return nil
+	}
+
+	// Nodes held through a staking collection might not be known by the staking table yet
+	nodeIDs := make([]string, len(stakerNodeId))
+	for i, staker := range stakerNodeId {
+		nodeIDs[i] = staker.NodeId
+	}
+
+	err := db.SaveStakingTable(types.NewStakingTable(0, nodeIDs))
+	if err != nil {
+		return fmt.Errorf("error while saving staker nodes: %s", err)
+	}
+
 	stmt := `INSERT INTO staker_node_id(address,node_id) VALUES `
 
 	var params []interface{}
@@ -198,7 +332,7 @@ func (db *Db) SaveStakerNodeId(stakerNodeId []types.StakerNodeId) error {
 	stmt = stmt[:len(stmt)-1]
 	stmt += ` ON CONFLICT DO NOTHING`
 
-	_, err := db.Sqlx.Exec(stmt, params...)
+	_, err = db.Sqlx.Exec(stmt, params...)
 	if err != nil {
 		return err
 	}
@@ -72,7 +72,7 @@ func (suite *DbTestSuite) TestSaveAccount() {
 
 	// Get Accounts row
 	var accountRows []dbtypes.AccountRow
-	err = suite.database.Sqlx.Select(&accountRows, `SELECT * FROM account`)
+	err = suite.database.Sqlx.Select(&accountRows, `SELECT address FROM account`)
 	suite.Require().NoError(err)
 	suite.Require().Len(accountRows, 1, "account table should contain only one row")
 
@@ -100,6 +100,108 @@ func (suite *DbTestSuite) TestSaveAccount() {
 
 }
 
+func (suite *DbTestSuite) TestSaveAccount_History() {
+	emptyContracts := make(map[string][]byte)
+	address := flow.HexToAddress("0x1")
+
+	for _, height := range []uint64{10, 20} {
+		acc, err := types.NewAccount(flow.Account{
+			Address:   address,
+			Balance:   height * 100,
+			Contracts: emptyContracts,
+		})
+		suite.Require().NoError(err)
+
+		err = suite.database.SaveAccounts([]types.Account{acc}, height)
+		suite.Require().NoError(err)
+	}
+
+	expectedEmptyContracts, err := json.Marshal(emptyContracts)
+	suite.Require().NoError(err)
+
+	// Historical data is enabled, so both the balances should be stored
+	var historyRows []dbtypes.AccountBalanceRow
+	err = suite.database.Sqlx.Select(&historyRows, `SELECT * FROM account_balance_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRows, 2)
+	suite.Require().True(historyRows[0].Equal(dbtypes.NewAccountBalanceRow(address.String(), 1000, "", string(expectedEmptyContracts), 10)))
+	suite.Require().True(historyRows[1].Equal(dbtypes.NewAccountBalanceRow(address.String(), 2000, "", string(expectedEmptyContracts), 20)))
+
+	var latestRows []dbtypes.AccountBalanceRow
+	err = suite.database.Sqlx.Select(&latestRows, `SELECT * FROM account_balance_latest`)
+	suite.Require().NoError(err)
+	suite.Require().Len(latestRows, 1)
+	suite.Require().True(latestRows[0].Equal(historyRows[1]))
+
+	// The account_balance table keeps a single row per address, containing the latest balance
+	var balanceRows []dbtypes.AccountBalanceRow
+	err = suite.database.Sqlx.Select(&balanceRows, `SELECT * FROM account_balance`)
+	suite.Require().NoError(err)
+	suite.Require().Len(balanceRows, 1)
+	suite.Require().True(balanceRows[0].Equal(historyRows[1]))
+
+	// Older balances should not replace the latest one
+	acc, err := types.NewAccount(flow.Account{Address: address, Balance: 500, Contracts: emptyContracts})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.database.SaveAccounts([]types.Account{acc}, 5))
+
+	err = suite.database.Sqlx.Select(&balanceRows, `SELECT * FROM account_balance`)
+	suite.Require().NoError(err)
+	suite.Require().Len(balanceRows, 1)
+	suite.Require().True(balanceRows[0].Equal(historyRows[1]))
+}
+
+func (suite *DbTestSuite) TestSaveAccount_WithoutHistory() {
+	db := suite.buildDatabase(false)
+
+	pubkeyString := "d0d45a9f40dc5e7440c71fcbc1a7836e7b38cc7874ac2875c5475fe550f582e005a5ed864c779d413fe49f58d3451c24ccf2cc12b9495c2baeeb0752538a0bcb"
+	pubkey, err := crypto.DecodePublicKeyHex(crypto.ECDSA_P256, pubkeyString)
+	suite.Require().NoError(err)
+
+	emptyContracts := make(map[string][]byte)
+	addresses := []flow.Address{flow.HexToAddress("0x1"), flow.HexToAddress("0x2")}
+
+	for _, height := range []uint64{10, 20} {
+		var accounts []types.Account
+		for _, address := range addresses {
+			// The second account is only refreshed once
+			if address == addresses[1] && height == 20 {
+				continue
+			}
+
+			acc, err := types.NewAccount(flow.Account{
+				Address:   address,
+				Balance:   height * 100,
+				Keys:      []*flow.AccountKey{flow.NewAccountKey().SetWeight(int(height)).SetSigAlgo(crypto.ECDSA_P256).SetHashAlgo(crypto.SHA2_256).SetPublicKey(pubkey)},
+				Contracts: emptyContracts,
+			})
+			suite.Require().NoError(err)
+			accounts = append(accounts, acc)
+		}
+
+		err = db.SaveAccounts(accounts, height)
+		suite.Require().NoError(err)
+	}
+
+	type addressHeight struct {
+		Address string `db:"address"`
+		Height  uint64 `db:"height"`
+	}
+
+	// Only the latest row of each address should be kept
+	expected := []addressHeight{{addresses[0].String(), 20}, {addresses[1].String(), 10}}
+
+	var balanceHeights []addressHeight
+	err = db.Sqlx.Select(&balanceHeights, `SELECT address, height FROM account_balance_history ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, balanceHeights)
+
+	var keyHeights []addressHeight
+	err = db.Sqlx.Select(&keyHeights, `SELECT address, height FROM account_key_list_history ORDER BY address`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, keyHeights)
+}
+
 func (suite *DbTestSuite) TestBigDipperDb_LockedAccount() {
 
 	// ------------------------------
@@ -36,6 +36,33 @@ func (db *Db) GetLastBlockHeight() (int64, error) {
 	return block.Height, nil
 }
 
+// GetFirstBlockHeight returns the first block height stored inside the database
+func (db *Db) GetFirstBlockHeight() (int64, error) {
+	var heights []int64
+	if err := db.Sqlx.Select(&heights, `SELECT height FROM block ORDER BY height LIMIT 1`); err != nil {
+		return 0, err
+	}
+
+	if len(heights) == 0 {
+		return 0, fmt.Errorf("cannot get block, no blocks saved")
+	}
+
+	return heights[0], nil
+}
+
+// GetBlockHeights returns the heights of the blocks stored inside the database between the given heights,
+// both included, in ascending order
+func (db *Db) GetBlockHeights(fromHeight int64, toHeight int64) ([]int64, error) {
+	stmt := `SELECT height FROM block WHERE height BETWEEN $1 AND $2 ORDER BY height`
+
+	var heights []int64
+	if err := db.Sqlx.Select(&heights, stmt, fromHeight, toHeight); err != nil {
+		return nil, fmt.Errorf("error while getting block heights: %s", err)
+	}
+
+	return heights, nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // getBlockHeightTime retrieves the block at the specific time
@@ -1,6 +1,7 @@
 package postgresql_test
 
 import (
+	"fmt"
 	time "time"
 
 	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
@@ -333,3 +334,23 @@ func (suite *DbTestSuite) TestSaveConsensus_GetGenesis() {
 		"testnet-1",
 	)))
 }
+
+func (suite *DbTestSuite) TestSaveConsensus_GetBlockHeights() {
+	timestamp, err := time.Parse(time.RFC3339, "2020-01-01T15:00:00Z")
+	suite.Require().NoError(err)
+
+	for _, height := range []int64{1000, 1002, 1005} {
+		_, err = suite.database.Sql.Exec(`INSERT INTO block(height, id, parent_id, collection_guarantees, timestamp)
+	VALUES ($1, $2, '2f708745fff4f66db88fac8f2f41d496edd341a2837d3e990e87679266e9bdb8', '[]', $3)`,
+			height, fmt.Sprintf("b3889bc09945045abf0f8d7d0d9109467c7e45c5a8e98dd355394f4fc47b%d", height), timestamp)
+		suite.Require().NoError(err)
+	}
+
+	first, err := suite.database.GetFirstBlockHeight()
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1000), first)
+
+	heights, err := suite.database.GetBlockHeights(1001, 1005)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{1002, 1005}, heights)
+}
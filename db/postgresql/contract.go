@@ -0,0 +1,43 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveContractVersions stores the given contract versions
+func (db *Db) SaveContractVersions(versions []types.ContractVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO contract_version(address,name,action,code_hash,source,transaction_id,height,event_index) VALUES `
+
+	var params []interface{}
+	for i, version := range versions {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		params = append(params,
+			version.Address,
+			version.Name,
+			version.Action,
+			version.CodeHash,
+			nullString(version.Source),
+			version.TransactionID,
+			version.Height,
+			version.EventIndex,
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (transaction_id, event_index) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving contract versions: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveContractVersions() {
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	versions := []types.ContractVersion{
+		types.NewContractVersion("0000000000000001", "Hello", types.ContractActionAdded,
+			"a1b2", "pub contract Hello {}", txID.String(), block.Height, 0),
+		types.NewContractVersion("0000000000000001", "World", types.ContractActionRemoved,
+			"c3d4", "", txID.String(), block.Height, 1),
+	}
+
+	err = suite.database.SaveContractVersions(versions)
+	suite.Require().NoError(err)
+
+	// Saving the same versions twice should not fail
+	err = suite.database.SaveContractVersions(versions)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.ContractVersionRow{
+		dbtypes.NewContractVersionRow("0000000000000001", "Hello", types.ContractActionAdded,
+			"a1b2", "pub contract Hello {}", txID.String(), block.Height, 0),
+		dbtypes.NewContractVersionRow("0000000000000001", "World", types.ContractActionRemoved,
+			"c3d4", "", txID.String(), block.Height, 1),
+	}
+
+	var rows []dbtypes.ContractVersionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM contract_version ORDER BY event_index`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
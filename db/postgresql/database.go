@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	juno "github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
 
 	"github.com/cosmos/cosmos-sdk/simapp/params"
 
@@ -21,6 +22,7 @@ type Db struct {
 	*database.Database
 	Sqlx                *sqlx.DB
 	storeHistoricalData bool
+	eventProjections    *eventProjections
 }
 
 // Builder allows to create a new Db instance implementing the db.Builder type
@@ -34,16 +36,17 @@ func Builder(cfg juno.Config, codec *params.EncodingConfig) (db.Database, error)
 	if !ok {
 		return nil, fmt.Errorf("invalid configuration database, must be PostgreSQL")
 	}
-	/*
-		dbCfg, ok := cfg.GetDatabaseConfig().(*config.DatabaseConfig)
-		if !ok {
-			return nil, fmt.Errorf("invalid database configuration type")
-		}
-	*/
+
+	dbCfg, ok := cfg.GetDatabaseConfig().(*config.DatabaseConfig)
+	if !ok {
+		return nil, fmt.Errorf("invalid database configuration type")
+	}
+
 	return &Db{
 		Database:            psqlDb,
 		Sqlx:                sqlx.NewDb(psqlDb.Sql, "postgresql"),
-		storeHistoricalData: true,
+		storeHistoricalData: dbCfg.ShouldStoreHistoricalData(),
+		eventProjections:    newEventProjections(dbCfg.GetEventProjections()),
 	}, nil
 }
 
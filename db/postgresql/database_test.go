@@ -34,6 +34,38 @@ type DbTestSuite struct {
 }
 
 func (suite *DbTestSuite) SetupTest() {
+	bigDipperDb := suite.buildDatabase(true)
+
+	// Delete the public schema
+	_, err := bigDipperDb.Sql.Exec(`DROP SCHEMA public CASCADE;`)
+	suite.Require().NoError(err)
+
+	// Re-create the schema
+	_, err = bigDipperDb.Sql.Exec(`CREATE SCHEMA public;`)
+	suite.Require().NoError(err)
+
+	dirPath := path.Join(".", "schema")
+	dir, err := ioutil.ReadDir(dirPath)
+	suite.Require().NoError(err)
+
+	for _, fileInfo := range dir {
+		file, err := ioutil.ReadFile(filepath.Join(dirPath, fileInfo.Name()))
+		suite.Require().NoError(err)
+
+		commentsRegExp := regexp.MustCompile(`/\*.*\*/`)
+		requests := strings.Split(string(file), ";")
+		for _, request := range requests {
+			_, err := bigDipperDb.Sql.Exec(commentsRegExp.ReplaceAllString(request, ""))
+			suite.Require().NoError(err)
+		}
+	}
+
+	suite.database = bigDipperDb
+}
+
+// buildDatabase builds a new database instance connected to the test database,
+// storing historical data only if storeHistoricalData is true
+func (suite *DbTestSuite) buildDatabase(storeHistoricalData bool) *database.Db {
 	// Create the codec
 	codec := simapp.MakeTestEncodingConfig()
 
@@ -52,7 +84,8 @@ func (suite *DbTestSuite) SetupTest() {
 				-1,
 				-1,
 			),
-			true,
+			storeHistoricalData,
+			nil,
 		),
 		nil, nil, nil, nil,
 	)
@@ -63,31 +96,7 @@ func (suite *DbTestSuite) SetupTest() {
 	bigDipperDb, ok := (db).(*database.Db)
 	suite.Require().True(ok)
 
-	// Delete the public schema
-	_, err = bigDipperDb.Sql.Exec(`DROP SCHEMA public CASCADE;`)
-	suite.Require().NoError(err)
-
-	// Re-create the schema
-	_, err = bigDipperDb.Sql.Exec(`CREATE SCHEMA public;`)
-	suite.Require().NoError(err)
-
-	dirPath := path.Join(".", "schema")
-	dir, err := ioutil.ReadDir(dirPath)
-	suite.Require().NoError(err)
-
-	for _, fileInfo := range dir {
-		file, err := ioutil.ReadFile(filepath.Join(dirPath, fileInfo.Name()))
-		suite.Require().NoError(err)
-
-		commentsRegExp := regexp.MustCompile(`/\*.*\*/`)
-		requests := strings.Split(string(file), ";")
-		for _, request := range requests {
-			_, err := bigDipperDb.Sql.Exec(commentsRegExp.ReplaceAllString(request, ""))
-			suite.Require().NoError(err)
-		}
-	}
-
-	suite.database = bigDipperDb
+	return bigDipperDb
 }
 
 // getBlock builds, stores and returns a block for the provided height
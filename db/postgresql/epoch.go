@@ -0,0 +1,104 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveEpochPhases stores the given epoch phases. The beginning of a staking phase marks the start
+// of a new epoch, so the start height of its epoch and the end height of the previous one are updated as well
+func (db *Db) SaveEpochPhases(phases []types.EpochPhase) error {
+	if len(phases) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO epoch_phase(counter,phase,height,timestamp) VALUES `
+
+	var params []interface{}
+	for i, phase := range phases {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params, phase.Counter, phase.Phase, phase.Height, phase.Timestamp)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (counter, phase) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving epoch phases: %s", err)
+	}
+
+	for _, phase := range phases {
+		if phase.Phase != types.EpochPhaseStaking {
+			continue
+		}
+
+		err = db.saveEpochStart(phase.Counter, phase.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveEpochStart stores the height at which the epoch having the given counter started,
+// which is also the height following the end of the previous epoch
+func (db *Db) saveEpochStart(counter uint64, height uint64) error {
+	stmt := `
+INSERT INTO epoch(counter,start_height) VALUES ($1,$2)
+ON CONFLICT (counter) DO UPDATE SET start_height = excluded.start_height`
+	_, err := db.Sqlx.Exec(stmt, counter, height)
+	if err != nil {
+		return fmt.Errorf("error while saving epoch start: %s", err)
+	}
+
+	if counter == 0 || height == 0 {
+		return nil
+	}
+
+	stmt = `
+INSERT INTO epoch(counter,end_height) VALUES ($1,$2)
+ON CONFLICT (counter) DO UPDATE SET end_height = excluded.end_height`
+	_, err = db.Sqlx.Exec(stmt, counter-1, height-1)
+	if err != nil {
+		return fmt.Errorf("error while saving epoch end: %s", err)
+	}
+
+	return nil
+}
+
+// SaveEpochSetup stores the views, random source, identity table and payload of the EpochSetup service event
+func (db *Db) SaveEpochSetup(setup types.EpochSetup) error {
+	stmt := `
+INSERT INTO epoch(counter,first_view,final_view,random_source,nodes,setup) VALUES ($1,$2,$3,$4,$5,$6)
+ON CONFLICT (counter) DO UPDATE 
+	SET first_view = excluded.first_view,
+		final_view = excluded.final_view,
+		random_source = excluded.random_source,
+		nodes = excluded.nodes,
+		setup = excluded.setup`
+
+	_, err := db.Sqlx.Exec(stmt,
+		setup.Counter, setup.FirstView, setup.FinalView, setup.RandomSource, string(setup.Nodes), string(setup.Payload))
+	if err != nil {
+		return fmt.Errorf("error while saving epoch setup: %s", err)
+	}
+
+	return nil
+}
+
+// SaveEpochCommit stores the payload of the EpochCommit service event
+func (db *Db) SaveEpochCommit(commit types.EpochCommit) error {
+	stmt := `
+INSERT INTO epoch(counter,commit) VALUES ($1,$2)
+ON CONFLICT (counter) DO UPDATE SET commit = excluded.commit`
+
+	_, err := db.Sqlx.Exec(stmt, commit.Counter, string(commit.Payload))
+	if err != nil {
+		return fmt.Errorf("error while saving epoch commit: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package postgresql_test
+
+import (
+	"database/sql"
+	"time"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveEpochPhases() {
+	timestamp := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	phases := []types.EpochPhase{
+		types.NewEpochPhase(1, types.EpochPhaseSetup, 90, timestamp),
+		types.NewEpochPhase(1, types.EpochPhaseCommitted, 95, timestamp),
+		types.NewEpochPhase(2, types.EpochPhaseStaking, 100, timestamp),
+	}
+
+	err := suite.database.SaveEpochPhases(phases)
+	suite.Require().NoError(err)
+
+	// Saving the same phases twice should not fail
+	err = suite.database.SaveEpochPhases(phases)
+	suite.Require().NoError(err)
+
+	var phaseRows []dbtypes.EpochPhaseRow
+	err = suite.database.Sqlx.Select(&phaseRows, `SELECT * FROM epoch_phase ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(phaseRows, len(phases))
+	for i, row := range phaseRows {
+		suite.Require().True(row.Equal(dbtypes.NewEpochPhaseRow(
+			phases[i].Counter, phases[i].Phase, phases[i].Height, phases[i].Timestamp,
+		)))
+	}
+
+	expected := []dbtypes.EpochRow{
+		dbtypes.NewEpochRow(1, sql.NullInt64{}, sql.NullInt64{Int64: 99, Valid: true},
+			sql.NullInt64{}, sql.NullInt64{}, sql.NullString{}),
+		dbtypes.NewEpochRow(2, sql.NullInt64{Int64: 100, Valid: true}, sql.NullInt64{},
+			sql.NullInt64{}, sql.NullInt64{}, sql.NullString{}),
+	}
+
+	var rows []dbtypes.EpochRow
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT counter, start_height, end_height, first_view, final_view, random_source FROM epoch ORDER BY counter`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveEpochSetupAndCommit() {
+	err := suite.database.SaveEpochSetup(types.NewEpochSetup(
+		2, 1000, 2000, "abcd",
+		[]byte(`[{"id":"node-1","role":"1"}]`),
+		[]byte(`{"counter":"2","firstView":"1000","finalView":"2000"}`),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveEpochCommit(types.NewEpochCommit(2, []byte(`{"counter":"2","dkgPubKeys":["key"]}`)))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveEpochPhases([]types.EpochPhase{
+		types.NewEpochPhase(2, types.EpochPhaseStaking, 100, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	suite.Require().NoError(err)
+
+	var row dbtypes.EpochRow
+	err = suite.database.Sqlx.Get(&row, `
+SELECT counter, start_height, end_height, first_view, final_view, random_source FROM epoch WHERE counter = 2`)
+	suite.Require().NoError(err)
+	suite.Require().True(row.Equal(dbtypes.NewEpochRow(2,
+		sql.NullInt64{Int64: 100, Valid: true}, sql.NullInt64{},
+		sql.NullInt64{Int64: 1000, Valid: true}, sql.NullInt64{Int64: 2000, Valid: true},
+		sql.NullString{String: "abcd", Valid: true},
+	)))
+
+	var nodeID, dkgKey string
+	err = suite.database.Sqlx.QueryRow(`SELECT nodes->0->>'id', commit->'dkgPubKeys'->>0 FROM epoch WHERE counter = 2`).
+		Scan(&nodeID, &dkgKey)
+	suite.Require().NoError(err)
+	suite.Require().Equal("node-1", nodeID)
+	suite.Require().Equal("key", dkgKey)
+}
@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lib/pq"
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// eventProjections keeps track of the event types that should be exposed through a typed view,
+// and of the ones for which the view has already been created
+type eventProjections struct {
+	mu      sync.Mutex
+	created map[string]bool
+}
+
+// newEventProjections allows to build a new eventProjections instance for the given event types
+func newEventProjections(eventTypes []string) *eventProjections {
+	created := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		created[eventType] = false
+	}
+	return &eventProjections{
+		created: created,
+	}
+}
+
+// shouldCreate tells whether the projection of the given event type is configured and still needs to be created
+func (p *eventProjections) shouldCreate(eventType string) bool {
+	if p == nil {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	created, found := p.created[eventType]
+	return found && !created
+}
+
+// setCreated marks the projection of the given event type as created
+func (p *eventProjections) setCreated(eventType string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.created[eventType] = true
+}
+
+// SaveEvents implements db.Database
+func (db *Db) SaveEvents(events []types.Event) error {
+	err := db.Database.SaveEvents(events)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if !db.eventProjections.shouldCreate(event.Type) || event.Value.EventType == nil {
+			continue
+		}
+
+		_, err = db.Sql.Exec(EventProjectionStatement(event.Type, event.Value.EventType.Fields))
+		if err != nil {
+			return fmt.Errorf("error while creating projection of event %s: %s", event.Type, err)
+		}
+		db.eventProjections.setCreated(event.Type)
+	}
+
+	return nil
+}
+
+var projectionNameRegExp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// EventProjectionName returns the name of the view exposing the events having the given type,
+// eg. A.1654653399040a61.FlowToken.TokensDeposited becomes event_a_1654653399040a61_flowtoken_tokensdeposited
+func EventProjectionName(eventType string) string {
+	return "event_" + projectionNameRegExp.ReplaceAllString(strings.ToLower(eventType), "_")
+}
+
+// EventProjectionStatement returns the statement creating a view that exposes each field of
+// the events having the given type as a typed column
+func EventProjectionStatement(eventType string, fields []cadence.Field) string {
+	columns := []string{"height", "transaction_id", "transaction_index", "event_index"}
+	for i, field := range fields {
+		sqlType := types.CadenceTypeToSQL(field.Type)
+
+		// Non-scalar values are taken from the JSON-CDC encoded value so that no data is lost
+		column := fmt.Sprintf("(value->'value'->'fields'->%d->'value')", i)
+		if sqlType != "JSONB" {
+			column = fmt.Sprintf("(fields->>%s)::%s", pq.QuoteLiteral(field.Identifier), sqlType)
+		}
+
+		columns = append(columns, fmt.Sprintf("%s AS %s", column, pq.QuoteIdentifier(field.Identifier)))
+	}
+
+	return fmt.Sprintf(`CREATE OR REPLACE VIEW %s AS SELECT %s FROM event WHERE type = %s`,
+		pq.QuoteIdentifier(EventProjectionName(eventType)), strings.Join(columns, ", "), pq.QuoteLiteral(eventType))
+}
+
+// GetEventTypes returns all the distinct types of the events stored inside the database, in ascending order
+func (db *Db) GetEventTypes() ([]string, error) {
+	// Skip from one type to the next one so that only the type index is read, instead of the whole table
+	stmt := `
+WITH RECURSIVE event_type AS (
+    (SELECT type FROM event ORDER BY type LIMIT 1)
+    UNION ALL
+    SELECT (SELECT type FROM event WHERE type > event_type.type ORDER BY type LIMIT 1)
+    FROM event_type WHERE event_type.type IS NOT NULL
+)
+SELECT type FROM event_type WHERE type IS NOT NULL`
+
+	var eventTypes []string
+	err := db.Sqlx.Select(&eventTypes, stmt)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting event types: %s", err)
+	}
+	return eventTypes, nil
+}
+
+// GetEventsByType returns all the events having one of the given types (eg. A.1654653399040a61.FlowToken.TokensDeposited)
+// that have been emitted between the given heights, both included, sorted by the order in which they have been emitted
+func (db *Db) GetEventsByType(eventTypes []string, fromHeight int64, toHeight int64) ([]types.Event, error) {
+	stmt := `
+SELECT height, type, transaction_id, transaction_index, event_index, value FROM event
+WHERE height BETWEEN $1 AND $2 AND type = ANY($3)
+ORDER BY height, transaction_index::BIGINT, event_index`
+
+	var rows []dbtypes.EventRow
+	err := db.Sqlx.Select(&rows, stmt, fromHeight, toHeight, pq.Array(eventTypes))
+	if err != nil {
+		return nil, fmt.Errorf("error while getting events: %s", err)
+	}
+
+	events := make([]types.Event, len(rows))
+	for i, row := range rows {
+		transactionIndex, err := strconv.Atoi(row.TransactionIndex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction index of event %s: %s", row.Type, err)
+		}
+
+		value, err := jsoncdc.Decode(row.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error while decoding event %s: %s", row.Type, err)
+		}
+
+		event, ok := value.(cadence.Event)
+		if !ok {
+			return nil, fmt.Errorf("cadence value is not an event: %s", value)
+		}
+
+		events[i] = types.NewEvent(int(row.Height), row.Type, row.TransactionID, transactionIndex, int(row.EventIndex), event)
+	}
+
+	return events, nil
+}
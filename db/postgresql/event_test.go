@@ -0,0 +1,111 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// getTokensDepositedEvent stores the block and collection the event belongs to,
+// and returns a FlowToken.TokensDeposited event emitted by the given transaction
+func (suite *DbTestSuite) getTokensDepositedEvent(txID flow.Identifier) types.Event {
+	block := suite.getBlock(10)
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	eventType := &cadence.EventType{
+		QualifiedIdentifier: "FlowToken.TokensDeposited",
+		Fields: []cadence.Field{
+			{Identifier: "amount", Type: cadence.UFix64Type{}},
+			{Identifier: "to", Type: cadence.OptionalType{Type: cadence.AddressType{}}},
+		},
+	}
+	value := cadence.NewEvent([]cadence.Value{
+		cadence.UFix64(1000000000),
+		cadence.NewOptional(cadence.BytesToAddress([]byte{0x1})),
+	}).WithType(eventType)
+
+	return types.NewEvent(int(block.Height), eventType.ID(), txID.String(), 0, 0, value)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveEvents() {
+	event := suite.getTokensDepositedEvent(flow.HexToID("0x6"))
+
+	err := suite.database.SaveEvents([]types.Event{event})
+	suite.Require().NoError(err)
+
+	var to string
+	err = suite.database.Sqlx.QueryRow(
+		`SELECT fields->>'to' FROM event WHERE type = $1`, event.Type).Scan(&to)
+	suite.Require().NoError(err)
+	suite.Require().Equal("0000000000000001", to)
+
+	var valueType string
+	err = suite.database.Sqlx.QueryRow(
+		`SELECT value->>'type' FROM event WHERE type = $1`, event.Type).Scan(&valueType)
+	suite.Require().NoError(err)
+	suite.Require().Equal("Event", valueType, "value should be stored as JSON-CDC")
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_EventProjection() {
+	event := suite.getTokensDepositedEvent(flow.HexToID("0x6"))
+
+	err := suite.database.SaveEvents([]types.Event{event})
+	suite.Require().NoError(err)
+
+	_, err = suite.database.Sql.Exec(database.EventProjectionStatement(event.Type, event.Value.EventType.Fields))
+	suite.Require().NoError(err)
+
+	var amount float64
+	stmt := `SELECT amount FROM ` + database.EventProjectionName(event.Type) + ` WHERE "to" = $1`
+	err = suite.database.Sqlx.QueryRow(stmt, "0000000000000001").Scan(&amount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(float64(10), amount)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetEventsByType() {
+	event := suite.getTokensDepositedEvent(flow.HexToID("0x6"))
+
+	err := suite.database.SaveEvents([]types.Event{event})
+	suite.Require().NoError(err)
+
+	events, err := suite.database.GetEventsByType([]string{event.Type}, 0, int64(event.Height))
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(event.Type, events[0].Type)
+	suite.Require().Equal(event.TransactionID, events[0].TransactionID)
+	suite.Require().Equal(event.Value.Fields, events[0].Value.Fields)
+
+	// Only the full type should match
+	events, err = suite.database.GetEventsByType([]string{"TokensDeposited"}, 0, int64(event.Height))
+	suite.Require().NoError(err)
+	suite.Require().Empty(events)
+
+	events, err = suite.database.GetEventsByType([]string{event.Type}, int64(event.Height)+1, int64(event.Height)+10)
+	suite.Require().NoError(err)
+	suite.Require().Empty(events)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetEventTypes() {
+	eventTypes, err := suite.database.GetEventTypes()
+	suite.Require().NoError(err)
+	suite.Require().Empty(eventTypes)
+
+	deposited := suite.getTokensDepositedEvent(flow.HexToID("0x6"))
+	withdrawn := deposited
+	withdrawn.Type = "FlowToken.TokensWithdrawn"
+	withdrawn.EventIndex = 1
+	again := deposited
+	again.EventIndex = 2
+
+	err = suite.database.SaveEvents([]types.Event{deposited, withdrawn, again})
+	suite.Require().NoError(err)
+
+	eventTypes, err = suite.database.GetEventTypes()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{deposited.Type, withdrawn.Type}, eventTypes)
+}
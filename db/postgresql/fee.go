@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveTransactionFees stores the given transaction fees, adding them to the daily aggregates of their payers.
+// Fees that have already been stored are ignored, so that they are never counted twice
+func (db *Db) SaveTransactionFees(fees []types.TransactionFee) error {
+	if len(fees) == 0 {
+		return nil
+	}
+
+	stmt := `
+WITH inserted AS (
+	INSERT INTO transaction_fee(transaction_id,height,payer,amount,inclusion_effort,execution_effort) VALUES `
+
+	var params []interface{}
+	for i, fee := range fees {
+		ai := i * 6
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6)
+
+		params = append(params,
+			fee.TransactionID,
+			fee.Height,
+			fee.Payer,
+			fee.Amount,
+			fee.InclusionEffort,
+			fee.ExecutionEffort,
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+	ON CONFLICT (transaction_id) DO NOTHING
+	RETURNING payer, height, amount, execution_effort
+)
+INSERT INTO transaction_fee_daily(payer,date,amount,execution_effort,transactions)
+SELECT inserted.payer, block.timestamp::DATE, SUM(inserted.amount), SUM(inserted.execution_effort), COUNT(*)
+FROM inserted
+JOIN block ON block.height = inserted.height
+GROUP BY inserted.payer, block.timestamp::DATE
+ON CONFLICT (payer, date) DO UPDATE
+	SET amount = transaction_fee_daily.amount + excluded.amount,
+	    execution_effort = transaction_fee_daily.execution_effort + excluded.execution_effort,
+	    transactions = transaction_fee_daily.transactions + excluded.transactions`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving transaction fees: %s", err)
+	}
+
+	return nil
+}
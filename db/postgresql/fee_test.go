@@ -0,0 +1,52 @@
+package postgresql_test
+
+import (
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTransactionFees() {
+	block := suite.getBlock(10)
+	txIDs := []flow.Identifier{flow.HexToID("0x6"), flow.HexToID("0x7")}
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, txIDs),
+	})
+	suite.Require().NoError(err)
+
+	fees := []types.TransactionFee{
+		types.NewTransactionFee(txIDs[0].String(), block.Height, "0000000000000001", 10000, 100000000, 20),
+		types.NewTransactionFee(txIDs[1].String(), block.Height, "0000000000000001", 1000, 0, 0),
+	}
+
+	err = suite.database.SaveTransactionFees(fees)
+	suite.Require().NoError(err)
+
+	// Saving the same fees twice should not change the aggregates
+	err = suite.database.SaveTransactionFees(fees)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.TransactionFeeRow{
+		dbtypes.NewTransactionFeeRow(txIDs[0].String(), block.Height, "0000000000000001", 10000, 100000000, 20),
+		dbtypes.NewTransactionFeeRow(txIDs[1].String(), block.Height, "0000000000000001", 1000, 0, 0),
+	}
+
+	var rows []dbtypes.TransactionFeeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM transaction_fee ORDER BY transaction_id`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+
+	var dailyRows []dbtypes.TransactionFeeDailyRow
+	err = suite.database.Sqlx.Select(&dailyRows, `SELECT * FROM transaction_fee_daily`)
+	suite.Require().NoError(err)
+	suite.Require().Len(dailyRows, 1)
+	suite.Require().True(dailyRows[0].Equal(dbtypes.NewTransactionFeeDailyRow(
+		"0000000000000001", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 11000, 20, 2,
+	)))
+}
@@ -0,0 +1,131 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+)
+
+// GetHeightAtTime returns the height of the latest block produced at or before the given time.
+// It returns false if no such block has been stored
+func (db *Db) GetHeightAtTime(timestamp time.Time) (int64, bool, error) {
+	stmt := `SELECT height FROM block WHERE timestamp <= $1 ORDER BY timestamp DESC, height DESC LIMIT 1`
+
+	var height int64
+	err := db.Sqlx.QueryRow(stmt, timestamp.UTC()).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("error while getting height at time: %s", err)
+	}
+
+	return height, true, nil
+}
+
+// checkHistoricalData returns an error if the history of the given table is not stored,
+// in which case only its latest rows are kept and they cannot be read at a past height
+func (db *Db) checkHistoricalData(table string) error {
+	if !db.storeHistoricalData {
+		return fmt.Errorf("cannot read %s at height: historical data is not stored", table)
+	}
+	return nil
+}
+
+// GetAccountBalanceAtHeight returns the latest balance of the given address stored at or before the given height.
+// It returns false if no balance has been stored by then, and an error if historical data is not stored
+func (db *Db) GetAccountBalanceAtHeight(address string, height int64) (dbtypes.AccountBalanceRow, bool, error) {
+	err := db.checkHistoricalData("account balance")
+	if err != nil {
+		return dbtypes.AccountBalanceRow{}, false, err
+	}
+
+	stmt := `
+SELECT * FROM account_balance_history
+WHERE address = $1 AND height <= $2
+ORDER BY height DESC
+LIMIT 1`
+
+	var row dbtypes.AccountBalanceRow
+	err = db.Sqlx.Get(&row, stmt, address, height)
+	if err == sql.ErrNoRows {
+		return dbtypes.AccountBalanceRow{}, false, nil
+	}
+	if err != nil {
+		return dbtypes.AccountBalanceRow{}, false, fmt.Errorf("error while getting account balance at height: %s", err)
+	}
+
+	return row, true, nil
+}
+
+// GetLockedAccountBalanceAtHeight returns the latest balance of the locked account owned by the given address
+// stored at or before the given height. It returns false if no balance has been stored by then, and an error
+// if historical data is not stored
+func (db *Db) GetLockedAccountBalanceAtHeight(address string, height int64) (dbtypes.LockedAccountBalanceRow, bool, error) {
+	err := db.checkHistoricalData("locked account balance")
+	if err != nil {
+		return dbtypes.LockedAccountBalanceRow{}, false, err
+	}
+
+	stmt := `
+SELECT locked_account_balance.*
+FROM locked_account_balance
+         JOIN locked_account ON locked_account.locked_address = locked_account_balance.locked_address
+WHERE locked_account.address = $1 AND locked_account_balance.height <= $2
+ORDER BY locked_account_balance.height DESC
+LIMIT 1`
+
+	var row dbtypes.LockedAccountBalanceRow
+	err = db.Sqlx.Get(&row, stmt, address, height)
+	if err == sql.ErrNoRows {
+		return dbtypes.LockedAccountBalanceRow{}, false, nil
+	}
+	if err != nil {
+		return dbtypes.LockedAccountBalanceRow{}, false, fmt.Errorf("error while getting locked account balance at height: %s", err)
+	}
+
+	return row, true, nil
+}
+
+// GetDelegatorInfosAtHeight returns, for each delegator controlled by the given address, the latest
+// delegator info stored at or before the given height.
+// Delegator infos are never pruned, so this works even if historical data is not stored
+func (db *Db) GetDelegatorInfosAtHeight(address string, height int64) ([]dbtypes.DelegatorInfoRow, error) {
+	stmt := `
+SELECT DISTINCT ON (delegator_info.node_id, delegator_info.id) delegator_info.*
+FROM delegator_account
+         JOIN delegator_info ON delegator_info.node_id = delegator_account.delegator_node_id
+    AND delegator_info.id = delegator_account.delegator_id
+WHERE delegator_account.account_address = $1 AND delegator_info.height::BIGINT <= $2
+ORDER BY delegator_info.node_id, delegator_info.id, delegator_info.height::BIGINT DESC`
+
+	var rows []dbtypes.DelegatorInfoRow
+	err := db.Sqlx.Select(&rows, stmt, address, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting delegator infos at height: %s", err)
+	}
+
+	return rows, nil
+}
+
+// GetNodeInfosAtHeight returns, for each node controlled by the given address, the latest
+// node info stored at or before the given height.
+// Node infos are never pruned, so this works even if historical data is not stored
+func (db *Db) GetNodeInfosAtHeight(address string, height int64) ([]dbtypes.NodeInfosFromTableRow, error) {
+	stmt := `
+SELECT DISTINCT ON (node_infos_from_table.id) node_infos_from_table.*
+FROM staker_node_id
+         JOIN node_infos_from_table ON node_infos_from_table.id = staker_node_id.node_id
+WHERE staker_node_id.address = $1 AND node_infos_from_table.height <= $2
+ORDER BY node_infos_from_table.id, node_infos_from_table.height DESC`
+
+	var rows []dbtypes.NodeInfosFromTableRow
+	err := db.Sqlx.Select(&rows, stmt, address, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting node infos at height: %s", err)
+	}
+
+	return rows, nil
+}
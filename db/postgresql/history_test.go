@@ -0,0 +1,186 @@
+package postgresql_test
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_GetHeightAtTime() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ids := []string{"0xa", "0xb"}
+	for i, height := range []uint64{10, 20} {
+		err := suite.database.SaveBlock(&flow.Block{
+			BlockHeader: flow.BlockHeader{
+				ID:        flow.HexToID(ids[i]),
+				ParentID:  flow.HexToID("0x2"),
+				Height:    height,
+				Timestamp: start.Add(time.Duration(i) * time.Hour),
+			},
+		})
+		suite.Require().NoError(err)
+	}
+
+	_, found, err := suite.database.GetHeightAtTime(start.Add(-time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	height, found, err := suite.database.GetHeightAtTime(start.Add(30 * time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(10), height)
+
+	height, found, err = suite.database.GetHeightAtTime(start.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(20), height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetAccountBalanceAtHeight() {
+	address := flow.HexToAddress("0x1")
+	for _, height := range []uint64{10, 20} {
+		account, err := types.NewAccount(flow.Account{
+			Address:   address,
+			Balance:   height * 100,
+			Contracts: map[string][]byte{},
+		})
+		suite.Require().NoError(err)
+
+		err = suite.database.SaveAccounts([]types.Account{account}, height)
+		suite.Require().NoError(err)
+	}
+
+	_, found, err := suite.database.GetAccountBalanceAtHeight(address.String(), 9)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	row, found, err := suite.database.GetAccountBalanceAtHeight(address.String(), 15)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(float64(1000), row.Balance)
+	suite.Require().Equal(uint64(10), row.Height)
+
+	row, found, err = suite.database.GetAccountBalanceAtHeight(address.String(), 20)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(float64(2000), row.Balance)
+	suite.Require().Equal(uint64(20), row.Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetLockedAccountBalanceAtHeight() {
+	suite.AddLockedAccount("0x1", "0x2")
+
+	err := suite.database.SaveLockedAccountBalance([]types.LockedAccountBalance{
+		types.NewLockedAccountBalance("0x2", 100, 10, 10),
+		types.NewLockedAccountBalance("0x2", 200, 20, 20),
+	})
+	suite.Require().NoError(err)
+
+	_, found, err := suite.database.GetLockedAccountBalanceAtHeight("0x1", 9)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	row, found, err := suite.database.GetLockedAccountBalanceAtHeight("0x1", 19)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().True(row.Equal(dbtypes.NewLockedAccountBalanceRow("0x2", 100, 10, 10)))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetDelegatorInfosAtHeight() {
+	nodeID := "2cfab7e9163475282f67186b06ce6eea7fa0687d25dd9c7a84532f2016bc2e5e"
+	suite.Require().NoError(suite.AddAccount("0x1"))
+	suite.Require().NoError(suite.InsertIntoStakingTable(1, nodeID))
+
+	err := suite.database.SaveDelegatorAccounts([]types.DelegatorAccount{
+		types.NewDelegatorAccount("0x1", 1, nodeID),
+		types.NewDelegatorAccount("0x1", 2, nodeID),
+	})
+	suite.Require().NoError(err)
+
+	// Heights 9 and 10 make sure the heights are compared as numbers rather than as text
+	for _, height := range []uint64{9, 10, 20} {
+		err = suite.database.SaveDelegatorInfo([]types.DelegatorNodeInfo{
+			types.NewDelegatorNodeInfo(1, nodeID, height, height, 0, 0, 0, 0),
+		}, height)
+		suite.Require().NoError(err)
+	}
+	err = suite.database.SaveDelegatorInfo([]types.DelegatorNodeInfo{
+		types.NewDelegatorNodeInfo(2, nodeID, 5, 5, 0, 0, 0, 0),
+	}, 15)
+	suite.Require().NoError(err)
+
+	rows, err := suite.database.GetDelegatorInfosAtHeight("0x1", 19)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.DelegatorInfoRow{
+		dbtypes.NewDelegatorInfoRow(1, nodeID, 10, 10, 0, 0, 0, 0, 10),
+		dbtypes.NewDelegatorInfoRow(2, nodeID, 5, 5, 0, 0, 0, 0, 15),
+	}
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+
+	rows, err = suite.database.GetDelegatorInfosAtHeight("0x1", 8)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_AtHeight_WithoutHistory() {
+	db := suite.buildDatabase(false)
+
+	// Pruned balances cannot be read at a past height
+	_, _, err := db.GetAccountBalanceAtHeight("0x1", 10)
+	suite.Require().Error(err)
+
+	_, _, err = db.GetLockedAccountBalanceAtHeight("0x1", 10)
+	suite.Require().Error(err)
+
+	// Delegator infos are never pruned, so they are still available
+	nodeID := "2cfab7e9163475282f67186b06ce6eea7fa0687d25dd9c7a84532f2016bc2e5e"
+	suite.Require().NoError(suite.AddAccount("0x1"))
+	suite.Require().NoError(suite.InsertIntoStakingTable(1, nodeID))
+
+	err = db.SaveDelegatorAccounts([]types.DelegatorAccount{types.NewDelegatorAccount("0x1", 1, nodeID)})
+	suite.Require().NoError(err)
+
+	for _, height := range []uint64{10, 20} {
+		err = db.SaveDelegatorInfo([]types.DelegatorNodeInfo{
+			types.NewDelegatorNodeInfo(1, nodeID, height, height, 0, 0, 0, 0),
+		}, height)
+		suite.Require().NoError(err)
+	}
+
+	rows, err := db.GetDelegatorInfosAtHeight("0x1", 15)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equal(dbtypes.NewDelegatorInfoRow(1, nodeID, 10, 10, 0, 0, 0, 0, 10)))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetNodeInfosAtHeight() {
+	nodeID := "e7df1454826425251716a703e907981672a43208ef3eabfc95d593673da778f6"
+	suite.Require().NoError(suite.AddAccount("0x1"))
+
+	err := suite.database.SaveStakerNodeId([]types.StakerNodeId{types.NewStakerNodeId("0x1", nodeID)})
+	suite.Require().NoError(err)
+
+	for _, height := range []uint64{10, 20} {
+		err = suite.database.SaveNodeInfosFromTable([]types.StakerNodeInfo{
+			types.NewStakerNodeInfo(nodeID, 1, "address", "networking", "staking",
+				height, 0, 0, 0, 0, []uint32{1}, 0, 0, 0),
+		}, height)
+		suite.Require().NoError(err)
+	}
+
+	rows, err := suite.database.GetNodeInfosAtHeight("0x1", 15)
+	suite.Require().NoError(err)
+
+	expected := dbtypes.NewNodeInfosFromTableRow(nodeID, 1, "address", "networking", "staking",
+		10, 0, 0, 0, 0, pq.Int32Array{1}, 0, 0, 0, 10)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equal(expected))
+}
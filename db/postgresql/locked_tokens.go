@@ -0,0 +1,88 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveLockedTokensEvents stores the given LockedTokens events
+func (db *Db) SaveLockedTokensEvents(events []types.LockedTokensEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO locked_tokens_event(transaction_id,event_index,height,type,address,node_id,amount,new_limit) VALUES `
+
+	var params []interface{}
+	for i, event := range events {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		params = append(params,
+			event.TransactionID,
+			event.EventIndex,
+			event.Height,
+			event.Type,
+			event.Address,
+			nullString(event.NodeID),
+			nullUint64(event.Amount),
+			nullUint64(event.NewLimit),
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (transaction_id, event_index) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving locked tokens events: %s", err)
+	}
+
+	return nil
+}
+
+// SaveLockedAccountOwners stores the given locked accounts, storing the accounts owning them as well
+func (db *Db) SaveLockedAccountOwners(accounts []types.LockedAccount) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account(address) VALUES `
+
+	var params []interface{}
+	for i, account := range accounts {
+		stmt += fmt.Sprintf("($%d),", i+1)
+		params = append(params, account.Address)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("fail to insert into account: %s", err)
+	}
+
+	err = db.SaveLockedAccount(accounts)
+	if err != nil {
+		return fmt.Errorf("error while saving locked accounts: %s", err)
+	}
+
+	return nil
+}
+
+// GetLockedAccountOwner returns the address of the account owning the given locked account.
+// It returns false if the locked account has never been stored
+func (db *Db) GetLockedAccountOwner(lockedAddress string) (string, bool, error) {
+	var address string
+	err := db.Sqlx.QueryRow(`SELECT address FROM locked_account WHERE locked_address = $1`, lockedAddress).Scan(&address)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("error while getting locked account owner: %s", err)
+	}
+
+	return address, true, nil
+}
@@ -0,0 +1,61 @@
+package postgresql_test
+
+import (
+	"database/sql"
+
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveLockedTokensEvents() {
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	increase, limit := uint64(100000000), uint64(300000000)
+	events := []types.LockedTokensEvent{
+		types.NewLockedTokensEvent(txID.String(), 0, block.Height, "UnlockLimitIncreased", "0x2", "", &increase, &limit),
+		types.NewLockedTokensEvent(txID.String(), 1, block.Height, "LockedAccountRegisteredAsNode", "0x2", "node-1", nil, nil),
+	}
+
+	err = suite.database.SaveLockedTokensEvents(events)
+	suite.Require().NoError(err)
+
+	// Saving the same events twice should not fail
+	err = suite.database.SaveLockedTokensEvents(events)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.LockedTokensEventRow{
+		dbtypes.NewLockedTokensEventRow(txID.String(), 0, block.Height, "UnlockLimitIncreased", "0x2", sql.NullString{},
+			sql.NullInt64{Int64: 100000000, Valid: true}, sql.NullInt64{Int64: 300000000, Valid: true}),
+		dbtypes.NewLockedTokensEventRow(txID.String(), 1, block.Height, "LockedAccountRegisteredAsNode", "0x2",
+			sql.NullString{String: "node-1", Valid: true}, sql.NullInt64{}, sql.NullInt64{}),
+	}
+
+	var rows []dbtypes.LockedTokensEventRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM locked_tokens_event ORDER BY event_index`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveLockedAccountOwners() {
+	_, found, err := suite.database.GetLockedAccountOwner("0x2")
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	err = suite.database.SaveLockedAccountOwners([]types.LockedAccount{types.NewLockedAccount("1", "0x2")})
+	suite.Require().NoError(err)
+
+	owner, found, err := suite.database.GetLockedAccountOwner("0x2")
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal("1", owner)
+}
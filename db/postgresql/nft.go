@@ -0,0 +1,170 @@
+package postgresql
+
+import (
+	"fmt"
+	"strconv"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveNFTTransfers stores the given non fungible token transfers
+func (db *Db) SaveNFTTransfers(transfers []types.NFTTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO nft_transfer(transaction_id,height,transfer_index,contract,token_id,"from","to") VALUES `
+
+	var params []interface{}
+	for i, transfer := range transfers {
+		ai := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7)
+
+		params = append(params,
+			transfer.TransactionID,
+			transfer.Height,
+			transfer.Index,
+			transfer.Contract,
+			strconv.FormatUint(transfer.TokenID, 10),
+			nullString(transfer.From),
+			nullString(transfer.To),
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (transaction_id, transfer_index) DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving nft transfers: %s", err)
+	}
+
+	return nil
+}
+
+// SaveNFTOwners stores the given non fungible token owners.
+// Owners are only replaced by the ones having an equal or greater height,
+// so that blocks and backfills can be processed in any order
+func (db *Db) SaveNFTOwners(owners []types.NFTOwner) error {
+	if len(owners) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO nft_owner(contract,token_id,owner,height) VALUES `
+
+	var params []interface{}
+	for i, owner := range owners {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+
+		params = append(params,
+			owner.Contract,
+			strconv.FormatUint(owner.TokenID, 10),
+			nullString(owner.Owner),
+			owner.Height,
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (contract, token_id) DO UPDATE
+	SET owner = excluded.owner,
+	    height = excluded.height
+WHERE nft_owner.height <= excluded.height`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving nft owners: %s", err)
+	}
+
+	return nil
+}
+
+// HasNFTMetadata tells whether the metadata of the given token has already been stored,
+// either because it has been resolved or because its resolution has been attempted
+func (db *Db) HasNFTMetadata(contract string, tokenID uint64) (bool, error) {
+	var exists bool
+	err := db.Sqlx.QueryRow(`SELECT EXISTS(SELECT 1 FROM nft_metadata WHERE contract = $1 AND token_id = $2)`,
+		contract, strconv.FormatUint(tokenID, 10)).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("error while checking nft metadata: %s", err)
+	}
+	return exists, nil
+}
+
+// SaveNFTMetadata stores the given non fungible token metadata, replacing the existing ones
+func (db *Db) SaveNFTMetadata(metadata []types.NFTMetadata) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO nft_metadata(contract,token_id,owner,name,description,thumbnail,external_url,height,attempts,error) VALUES `
+
+	var params []interface{}
+	for i, m := range metadata {
+		ai := i * 10
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
+			ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8, ai+9, ai+10)
+
+		params = append(params,
+			m.Contract,
+			strconv.FormatUint(m.TokenID, 10),
+			m.Owner,
+			nullString(m.Name),
+			nullString(m.Description),
+			nullString(m.Thumbnail),
+			nullString(m.ExternalURL),
+			m.Height,
+			m.Attempts,
+			nullString(m.Error),
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (contract, token_id) DO UPDATE
+	SET owner = excluded.owner,
+	    name = excluded.name,
+	    description = excluded.description,
+	    thumbnail = excluded.thumbnail,
+	    external_url = excluded.external_url,
+	    height = excluded.height,
+	    attempts = excluded.attempts,
+	    error = excluded.error`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving nft metadata: %s", err)
+	}
+
+	return nil
+}
+
+// GetFailedNFTMetadata returns the tokens whose metadata resolution failed less than maxAttempts times.
+// The owner of each token is the current one, if it is tracked inside the nft_owner table
+func (db *Db) GetFailedNFTMetadata(maxAttempts int) ([]types.NFTMetadata, error) {
+	stmt := `
+SELECT nft_metadata.contract, nft_metadata.token_id, COALESCE(nft_owner.owner, nft_metadata.owner) AS owner,
+       nft_metadata.height, nft_metadata.attempts, nft_metadata.error
+FROM nft_metadata
+LEFT JOIN nft_owner ON nft_owner.contract = nft_metadata.contract AND nft_owner.token_id = nft_metadata.token_id
+WHERE nft_metadata.error IS NOT NULL AND nft_metadata.attempts < $1`
+
+	var rows []dbtypes.NFTMetadataRow
+	err := db.Sqlx.Select(&rows, stmt, maxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting failed nft metadata: %s", err)
+	}
+
+	metadata := make([]types.NFTMetadata, len(rows))
+	for i, row := range rows {
+		metadata[i] = types.NFTMetadata{
+			Contract: row.Contract,
+			TokenID:  row.TokenID,
+			Owner:    row.Owner,
+			Height:   row.Height,
+			Attempts: row.Attempts,
+			Error:    row.Error.String,
+		}
+	}
+	return metadata, nil
+}
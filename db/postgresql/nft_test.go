@@ -0,0 +1,164 @@
+package postgresql_test
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveNFTTransfers() {
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	contract := "A.0b2a3299cc857e29.TopShot"
+	transfers := []types.NFTTransfer{
+		types.NewNFTTransfer(txID.String(), block.Height, 0, contract, 1, "0000000000000001", "0000000000000002"),
+		types.NewNFTTransfer(txID.String(), block.Height, 1, contract, 18446744073709551615, "", "0000000000000002"),
+	}
+
+	err = suite.database.SaveNFTTransfers(transfers)
+	suite.Require().NoError(err)
+
+	// Saving the same transfers twice should not fail
+	err = suite.database.SaveNFTTransfers(transfers)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.NFTTransferRow{
+		dbtypes.NewNFTTransferRow(txID.String(), block.Height, 0, contract, 1, "0000000000000001", "0000000000000002"),
+		dbtypes.NewNFTTransferRow(txID.String(), block.Height, 1, contract, 18446744073709551615, "", "0000000000000002"),
+	}
+
+	var rows []dbtypes.NFTTransferRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM nft_transfer ORDER BY transfer_index`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveNFTOwners() {
+	contract := "A.0b2a3299cc857e29.TopShot"
+
+	err := suite.database.SaveNFTOwners([]types.NFTOwner{
+		types.NewNFTOwner(contract, 1, "0000000000000001", 10),
+		types.NewNFTOwner(contract, 2, "0000000000000001", 10),
+	})
+	suite.Require().NoError(err)
+
+	// Owners at a lower height should not override the existing ones
+	err = suite.database.SaveNFTOwners([]types.NFTOwner{
+		types.NewNFTOwner(contract, 1, "0000000000000002", 11),
+		types.NewNFTOwner(contract, 2, "0000000000000003", 9),
+	})
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.NFTOwnerRow{
+		dbtypes.NewNFTOwnerRow(contract, 1, "0000000000000002", 11),
+		dbtypes.NewNFTOwnerRow(contract, 2, "0000000000000001", 10),
+	}
+
+	var rows []dbtypes.NFTOwnerRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM nft_owner ORDER BY token_id`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveNFTMetadata() {
+	contract := "A.0b2a3299cc857e29.TopShot"
+
+	found, err := suite.database.HasNFTMetadata(contract, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	err = suite.database.SaveNFTMetadata([]types.NFTMetadata{
+		types.NewNFTMetadata(contract, 1, "0000000000000001", "Moment", "A moment", "https://image", "", 10, 1),
+		types.NewFailedNFTMetadata(contract, 2, "0000000000000001", 10, 1, fmt.Errorf("cannot borrow")),
+	})
+	suite.Require().NoError(err)
+
+	found, err = suite.database.HasNFTMetadata(contract, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// The failed metadata should be retried using the current owner
+	err = suite.database.SaveNFTOwners([]types.NFTOwner{
+		types.NewNFTOwner(contract, 2, "0000000000000002", 11),
+	})
+	suite.Require().NoError(err)
+
+	failed, err := suite.database.GetFailedNFTMetadata(3)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NFTMetadata{
+		types.NewFailedNFTMetadata(contract, 2, "0000000000000002", 10, 1, fmt.Errorf("cannot borrow")),
+	}, failed)
+
+	failed, err = suite.database.GetFailedNFTMetadata(1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(failed)
+
+	// Resolving the metadata should replace the failure
+	err = suite.database.SaveNFTMetadata([]types.NFTMetadata{
+		types.NewNFTMetadata(contract, 2, "0000000000000002", "Moment", "", "", "", 12, 2),
+	})
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.NFTMetadataRow{
+		dbtypes.NewNFTMetadataRow(contract, 1, "0000000000000001", "Moment", "A moment", "https://image", "", 10, 1, ""),
+		dbtypes.NewNFTMetadataRow(contract, 2, "0000000000000002", "Moment", "", "", "", 12, 2, ""),
+	}
+
+	var rows []dbtypes.NFTMetadataRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM nft_metadata ORDER BY token_id`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetFailedNFTMetadata() {
+	contract := "A.0b2a3299cc857e29.TopShot"
+
+	err := suite.database.SaveNFTMetadata([]types.NFTMetadata{
+		types.NewNFTMetadata(contract, 1, "0000000000000001", "Moment", "", "", "", 10, 1),
+		types.NewFailedNFTMetadata(contract, 2, "0000000000000001", 10, 1, fmt.Errorf("cannot borrow")),
+		types.NewFailedNFTMetadata(contract, 3, "0000000000000001", 10, 2, fmt.Errorf("cannot borrow")),
+	})
+	suite.Require().NoError(err)
+
+	// Resolved tokens should never be retried, and tokens without a tracked owner keep their own one
+	failed, err := suite.database.GetFailedNFTMetadata(3)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.NFTMetadata{
+		types.NewFailedNFTMetadata(contract, 2, "0000000000000001", 10, 1, fmt.Errorf("cannot borrow")),
+		types.NewFailedNFTMetadata(contract, 3, "0000000000000001", 10, 2, fmt.Errorf("cannot borrow")),
+	}, failed)
+
+	// Tokens that reached the max attempts should be given up
+	err = suite.database.SaveNFTMetadata([]types.NFTMetadata{
+		types.NewFailedNFTMetadata(contract, 3, "0000000000000001", 11, 3, fmt.Errorf("cannot borrow")),
+	})
+	suite.Require().NoError(err)
+
+	failed, err = suite.database.GetFailedNFTMetadata(3)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NFTMetadata{
+		types.NewFailedNFTMetadata(contract, 2, "0000000000000001", 10, 1, fmt.Errorf("cannot borrow")),
+	}, failed)
+
+	// Raising the max attempts should retry them again
+	failed, err = suite.database.GetFailedNFTMetadata(4)
+	suite.Require().NoError(err)
+	suite.Require().Len(failed, 2)
+}
@@ -0,0 +1,226 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// GetEpochsWithoutRewards returns the ended epochs whose rewards have not been computed yet,
+// or for which no rewards payment had been found when they were computed.
+// Epochs whose rewards have already been computed maxAttempts times are not returned
+func (db *Db) GetEpochsWithoutRewards(maxAttempts int) ([]types.EpochRange, error) {
+	stmt := `
+SELECT epoch.counter, epoch.start_height, epoch.end_height,
+       COALESCE(EXTRACT(EPOCH FROM next_phase.timestamp - phase.timestamp), 0) AS duration
+FROM epoch
+         LEFT JOIN epoch_phase phase ON phase.counter = epoch.counter AND phase.phase = $1
+         LEFT JOIN epoch_phase next_phase ON next_phase.counter = epoch.counter + 1 AND next_phase.phase = $1
+WHERE epoch.start_height IS NOT NULL
+  AND epoch.end_height IS NOT NULL
+  AND NOT EXISTS(
+        SELECT 1 FROM node_epoch_reward
+        WHERE node_epoch_reward.epoch = epoch.counter AND node_epoch_reward.paid_reward IS NOT NULL
+    )
+  AND NOT EXISTS(
+        SELECT 1 FROM epoch_reward_attempt
+        WHERE epoch_reward_attempt.epoch = epoch.counter AND epoch_reward_attempt.attempts >= $2
+    )
+ORDER BY epoch.counter`
+
+	var rows []dbtypes.EpochRangeRow
+	err := db.Sqlx.Select(&rows, stmt, types.EpochPhaseStaking, maxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting epochs without rewards: %s", err)
+	}
+
+	epochs := make([]types.EpochRange, len(rows))
+	for i, row := range rows {
+		epochs[i] = types.NewEpochRange(row.Counter, row.StartHeight, row.EndHeight,
+			time.Duration(row.Duration*float64(time.Second)))
+	}
+	return epochs, nil
+}
+
+// SaveEpochRewardAttempt increases by one the number of times the rewards of the given epoch have been computed
+func (db *Db) SaveEpochRewardAttempt(epoch uint64) error {
+	stmt := `
+INSERT INTO epoch_reward_attempt(epoch, attempts) VALUES ($1, 1)
+ON CONFLICT (epoch) DO UPDATE 
+	SET attempts = epoch_reward_attempt.attempts + 1`
+
+	_, err := db.Sqlx.Exec(stmt, epoch)
+	if err != nil {
+		return fmt.Errorf("error while saving epoch reward attempt: %s", err)
+	}
+
+	return nil
+}
+
+// GetNodeStakes returns the tokens staked by each node inside the latest snapshot taken between the given heights
+func (db *Db) GetNodeStakes(fromHeight, toHeight uint64) ([]types.NodeStake, error) {
+	stmt := `
+SELECT id AS node_id, role, tokens_staked FROM node_infos_from_table
+WHERE height = (SELECT MAX(height) FROM node_infos_from_table WHERE height BETWEEN $1 AND $2)`
+
+	var rows []dbtypes.NodeStakeRow
+	err := db.Sqlx.Select(&rows, stmt, fromHeight, toHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting node stakes: %s", err)
+	}
+
+	stakes := make([]types.NodeStake, len(rows))
+	for i, row := range rows {
+		stakes[i] = types.NewNodeStake(row.NodeID, row.Role, row.TokensStaked)
+	}
+	return stakes, nil
+}
+
+// GetDelegatorStakes returns the tokens staked by each delegator inside the latest snapshot taken between the given heights
+func (db *Db) GetDelegatorStakes(fromHeight, toHeight uint64) ([]types.DelegatorStake, error) {
+	stmt := `
+SELECT node_id, id AS delegator_id, tokens_staked::NUMERIC AS tokens_staked FROM delegator_info
+WHERE height::BIGINT = (SELECT MAX(height::BIGINT) FROM delegator_info WHERE height::BIGINT BETWEEN $1 AND $2)`
+
+	var rows []dbtypes.DelegatorStakeRow
+	err := db.Sqlx.Select(&rows, stmt, fromHeight, toHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting delegator stakes: %s", err)
+	}
+
+	stakes := make([]types.DelegatorStake, len(rows))
+	for i, row := range rows {
+		stakes[i] = types.NewDelegatorStake(row.NodeID, row.DelegatorID, row.TokensStaked)
+	}
+	return stakes, nil
+}
+
+// GetRewardParameters returns the weekly payout and the delegators cut percentage in force at the given height.
+// It returns false if any of them has never been stored
+func (db *Db) GetRewardParameters(height uint64) (payout uint64, cut uint64, found bool, err error) {
+	err = db.Sqlx.QueryRow(
+		`SELECT payout::NUMERIC FROM weekly_payout WHERE height <= $1 ORDER BY height DESC LIMIT 1`, height,
+	).Scan(&payout)
+	if err == sql.ErrNoRows {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("error while getting weekly payout: %s", err)
+	}
+
+	err = db.Sqlx.QueryRow(
+		`SELECT cut_percentage FROM cut_percentage WHERE height <= $1 ORDER BY height DESC LIMIT 1`, height,
+	).Scan(&cut)
+	if err == sql.ErrNoRows {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("error while getting cut percentage: %s", err)
+	}
+
+	return payout, cut, true, nil
+}
+
+// GetPaidRewards returns the amounts of the RewardsPaid and DelegatorRewardsPaid events
+// stored with a height greater than fromHeight and lower or equal to toHeight
+func (db *Db) GetPaidRewards(fromHeight, toHeight uint64) ([]types.PaidReward, error) {
+	stmt := `
+SELECT node_id, delegator_id, amount FROM staking_event
+WHERE type IN ('RewardsPaid', 'DelegatorRewardsPaid') AND height > $1 AND height <= $2`
+
+	var rows []dbtypes.PaidRewardRow
+	err := db.Sqlx.Select(&rows, stmt, fromHeight, toHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting paid rewards: %s", err)
+	}
+
+	rewards := make([]types.PaidReward, len(rows))
+	for i, row := range rows {
+		var delegatorID *uint32
+		if row.DelegatorID.Valid {
+			id := uint32(row.DelegatorID.Int64)
+			delegatorID = &id
+		}
+		rewards[i] = types.NewPaidReward(row.NodeID, delegatorID, row.Amount)
+	}
+	return rewards, nil
+}
+
+// SaveNodeEpochRewards stores the given node rewards, replacing the ones already computed for the same epochs
+func (db *Db) SaveNodeEpochRewards(rewards []types.NodeEpochReward) error {
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	var nodeIDs []string
+	for _, reward := range rewards {
+		nodeIDs = append(nodeIDs, reward.NodeID)
+	}
+
+	err := db.saveStakingNodeIDs(nodeIDs)
+	if err != nil {
+		return fmt.Errorf("error while saving rewarded nodes: %s", err)
+	}
+
+	stmt := `
+INSERT INTO node_epoch_reward(epoch,node_id,tokens_staked,computed_reward,paid_reward,reconciled,apy) VALUES `
+
+	var params []interface{}
+	for i, reward := range rewards {
+		ai := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7)
+		params = append(params, reward.Epoch, reward.NodeID, reward.TokensStaked, reward.ComputedReward,
+			nullUint64(reward.PaidReward), reward.Reconciled, reward.APY)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (epoch, node_id) DO UPDATE 
+	SET tokens_staked = excluded.tokens_staked,
+		computed_reward = excluded.computed_reward,
+		paid_reward = excluded.paid_reward,
+		reconciled = excluded.reconciled,
+		apy = excluded.apy`
+
+	_, err = db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving node epoch rewards: %s", err)
+	}
+
+	return nil
+}
+
+// SaveDelegatorEpochRewards stores the given delegator rewards, replacing the ones already computed for the same epochs
+func (db *Db) SaveDelegatorEpochRewards(rewards []types.DelegatorEpochReward) error {
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO delegator_epoch_reward(epoch,node_id,delegator_id,tokens_staked,computed_reward,paid_reward,reconciled,apy) VALUES `
+
+	var params []interface{}
+	for i, reward := range rewards {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+		params = append(params, reward.Epoch, reward.NodeID, reward.DelegatorID, reward.TokensStaked,
+			reward.ComputedReward, nullUint64(reward.PaidReward), reward.Reconciled, reward.APY)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (epoch, node_id, delegator_id) DO UPDATE 
+	SET tokens_staked = excluded.tokens_staked,
+		computed_reward = excluded.computed_reward,
+		paid_reward = excluded.paid_reward,
+		reconciled = excluded.reconciled,
+		apy = excluded.apy`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving delegator epoch rewards: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package postgresql_test
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_GetEpochRewardsData() {
+	nodeID := "2cfab7e9163475282f67186b06ce6eea7fa0687d25dd9c7a84532f2016bc2e5e"
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := suite.database.SaveEpochPhases([]types.EpochPhase{
+		types.NewEpochPhase(1, types.EpochPhaseStaking, 1, start),
+		types.NewEpochPhase(2, types.EpochPhaseStaking, 10, start.Add(7*24*time.Hour)),
+	})
+	suite.Require().NoError(err)
+
+	// Snapshots taken during the epoch, the latest of which should be used
+	err = suite.InsertIntoStakingTable(1, nodeID)
+	suite.Require().NoError(err)
+	for height, staked := range map[uint64]uint64{2: 100, 5: 200, 12: 300} {
+		err = suite.database.SaveNodeInfosFromTable([]types.StakerNodeInfo{
+			types.NewStakerNodeInfo(nodeID, 1, "", "", "", staked, 0, 0, 0, 0, []uint32{1}, 1, 0, 0),
+		}, height)
+		suite.Require().NoError(err)
+
+		err = suite.database.SaveDelegatorInfo([]types.DelegatorNodeInfo{
+			types.NewDelegatorNodeInfo(1, nodeID, 0, staked/2, 0, 0, 0, 0),
+		}, height)
+		suite.Require().NoError(err)
+	}
+
+	err = suite.database.SaveWeeklyPayout(types.NewWeeklyPayout(1, 1000))
+	suite.Require().NoError(err)
+	err = suite.database.SaveCutPercentage(types.NewCutPercentage(8000000, 1))
+	suite.Require().NoError(err)
+
+	// Rewards paid inside the first block of the following epoch
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err = suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	delegatorID := uint32(1)
+	nodeAmount, delegatorAmount := uint64(700), uint64(300)
+	err = suite.database.SaveStakingEvents([]types.StakingEvent{
+		types.NewStakingEvent(txID.String(), 0, block.Height, "RewardsPaid", nodeID, nil, &nodeAmount, []byte(`{}`)),
+		types.NewStakingEvent(txID.String(), 1, block.Height, "DelegatorRewardsPaid", nodeID, &delegatorID, &delegatorAmount, []byte(`{}`)),
+	})
+	suite.Require().NoError(err)
+
+	epochs, err := suite.database.GetEpochsWithoutRewards(3)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EpochRange{types.NewEpochRange(1, 1, 9, 7*24*time.Hour)}, epochs)
+
+	nodes, err := suite.database.GetNodeStakes(1, 9)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NodeStake{types.NewNodeStake(nodeID, 1, 200)}, nodes)
+
+	delegators, err := suite.database.GetDelegatorStakes(1, 9)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DelegatorStake{types.NewDelegatorStake(nodeID, 1, 100)}, delegators)
+
+	payout, cut, found, err := suite.database.GetRewardParameters(9)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1000), payout)
+	suite.Require().Equal(uint64(8000000), cut)
+
+	paid, err := suite.database.GetPaidRewards(1, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PaidReward{
+		types.NewPaidReward(nodeID, nil, nodeAmount),
+		types.NewPaidReward(nodeID, &delegatorID, delegatorAmount),
+	}, paid)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveEpochRewards() {
+	nodeID := "2cfab7e9163475282f67186b06ce6eea7fa0687d25dd9c7a84532f2016bc2e5e"
+	paid := uint64(700)
+
+	err := suite.database.SaveNodeEpochRewards([]types.NodeEpochReward{
+		types.NewNodeEpochReward(1, nodeID, 200, 680, nil, false, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Computing the rewards again should replace them
+	err = suite.database.SaveNodeEpochRewards([]types.NodeEpochReward{
+		types.NewNodeEpochReward(1, nodeID, 200, 680, &paid, false, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveDelegatorEpochRewards([]types.DelegatorEpochReward{
+		types.NewDelegatorEpochReward(1, nodeID, 1, 100, 300, nil, false, 5),
+	})
+	suite.Require().NoError(err)
+
+	var nodeRows []dbtypes.NodeEpochRewardRow
+	err = suite.database.Sqlx.Select(&nodeRows, `SELECT * FROM node_epoch_reward`)
+	suite.Require().NoError(err)
+	suite.Require().Len(nodeRows, 1)
+	suite.Require().True(nodeRows[0].Equal(dbtypes.NewNodeEpochRewardRow(
+		1, nodeID, 200, 680, sql.NullInt64{Int64: 700, Valid: true}, false, 10,
+	)))
+
+	var delegatorRows []dbtypes.DelegatorEpochRewardRow
+	err = suite.database.Sqlx.Select(&delegatorRows, `SELECT * FROM delegator_epoch_reward`)
+	suite.Require().NoError(err)
+	suite.Require().Len(delegatorRows, 1)
+	suite.Require().True(delegatorRows[0].Equal(dbtypes.NewDelegatorEpochRewardRow(
+		1, nodeID, 1, 100, 300, sql.NullInt64{}, false, 5,
+	)))
+
+	// Epochs having paid rewards should not be returned anymore
+	err = suite.database.SaveEpochPhases([]types.EpochPhase{
+		types.NewEpochPhase(1, types.EpochPhaseStaking, 1, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewEpochPhase(2, types.EpochPhaseStaking, 10, time.Date(2021, 1, 8, 0, 0, 0, 0, time.UTC)),
+	})
+	suite.Require().NoError(err)
+
+	epochs, err := suite.database.GetEpochsWithoutRewards(3)
+	suite.Require().NoError(err)
+	suite.Require().Empty(epochs)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveEpochRewardAttempt() {
+	err := suite.database.SaveEpochPhases([]types.EpochPhase{
+		types.NewEpochPhase(1, types.EpochPhaseStaking, 1, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewEpochPhase(2, types.EpochPhaseStaking, 10, time.Date(2021, 1, 8, 0, 0, 0, 0, time.UTC)),
+	})
+	suite.Require().NoError(err)
+
+	// Epochs without any paid reward should be returned until the max attempts are reached
+	for i := 0; i < 2; i++ {
+		epochs, err := suite.database.GetEpochsWithoutRewards(2)
+		suite.Require().NoError(err)
+		suite.Require().Len(epochs, 1)
+
+		err = suite.database.SaveEpochRewardAttempt(1)
+		suite.Require().NoError(err)
+	}
+
+	epochs, err := suite.database.GetEpochsWithoutRewards(2)
+	suite.Require().NoError(err)
+	suite.Require().Empty(epochs)
+
+	var attempts int
+	err = suite.database.Sqlx.QueryRow(`SELECT attempts FROM epoch_reward_attempt WHERE epoch = 1`).Scan(&attempts)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, attempts)
+}
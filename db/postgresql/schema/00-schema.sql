@@ -9,6 +9,7 @@ CREATE TABLE block
 
 CREATE INDEX block_index ON block (height);
 CREATE INDEX block_id_index ON block (id);
+CREATE INDEX block_timestamp_index ON block (timestamp);
 
 
 CREATE TABLE block_seal
@@ -33,13 +34,20 @@ CREATE INDEX collection_index ON collection (height);
 CREATE INDEX collection_transaction_id_index ON collection (transaction_id);
 
 
+CREATE TABLE script
+(
+    hash   TEXT NOT NULL PRIMARY KEY,
+    script TEXT NOT NULL
+);
+
+
 CREATE TABLE transaction
 (
 		height BIGINT NOT NULL REFERENCES block (height),
         transaction_id TEXT NOT NULL REFERENCES collection (transaction_id),
 
-		script TEXT ,
-		arguments TEXT[],
+		script_hash TEXT REFERENCES script (hash),
+		arguments JSONB,
 		reference_block_id TEXT,
 		gas_limit BIGINT,
 		proposal_key TEXT,
@@ -49,6 +57,20 @@ CREATE TABLE transaction
 		envelope_signatures JSONB
 );
 CREATE INDEX transaction_index ON transaction (height);
+CREATE INDEX transaction_script_hash_index ON transaction (script_hash);
+
+
+CREATE TABLE account_transaction
+(
+    address        TEXT   NOT NULL,
+    transaction_id TEXT   NOT NULL REFERENCES collection (transaction_id),
+    height         BIGINT NOT NULL REFERENCES block (height),
+    role           TEXT   NOT NULL,
+    PRIMARY KEY (address, transaction_id, role)
+);
+
+CREATE INDEX account_transaction_address_height_index ON account_transaction (address, height DESC);
+CREATE INDEX account_transaction_transaction_id_index ON account_transaction (transaction_id);
 
 
 CREATE TABLE transaction_result
@@ -69,10 +91,13 @@ CREATE TABLE event
     transaction_id TEXT REFERENCES collection (transaction_id),
     transaction_index TEXT,
     event_index BIGINT,
-    value TEXT
+    value JSONB,
+    fields JSONB
 );
 
 CREATE INDEX event_index ON event (height);
+CREATE INDEX event_type_index ON event (type);
+CREATE INDEX event_fields_index ON event USING GIN (fields);
 
 
 CREATE TABLE pruning
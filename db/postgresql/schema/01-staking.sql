@@ -72,6 +72,15 @@ CREATE TABLE node_total_commitment_without_delegators
 CREATE INDEX node_total_commitment_without_delegators_index ON node_total_commitment_without_delegators (height);
 
 
+CREATE TABLE node_unstaking_tokens
+(  node_id TEXT NOT NULL REFERENCES staking_table (node_id),
+  token_unstaking BIGINT NOT NULL ,
+  height BIGINT  NOT NULL
+);
+
+CREATE INDEX node_unstaking_tokens_index ON node_unstaking_tokens (height);
+
+
 CREATE TABLE node_infos_from_table
 (  id TEXT  NOT NULL REFERENCES staking_table (node_id),
   role BIGINT  NOT NULL ,
@@ -91,6 +100,7 @@ CREATE TABLE node_infos_from_table
 );
 
 CREATE INDEX node_infos_from_table_index ON node_infos_from_table (height);
+CREATE INDEX node_infos_from_table_id_height_index ON node_infos_from_table (id, height DESC);
 
 
 CREATE TABLE cut_percentage
@@ -114,4 +124,5 @@ CREATE TABLE delegator_info
 );
 
 CREATE INDEX delegator_info_index ON delegator_info (height);
+CREATE INDEX delegator_info_delegator_height_index ON delegator_info (node_id, id, (height::BIGINT) DESC);
 
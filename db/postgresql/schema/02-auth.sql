@@ -1,8 +1,11 @@
 CREATE TABLE account
 (
-    address TEXT UNIQUE PRIMARY KEY NOT NULL
+    address            TEXT UNIQUE PRIMARY KEY NOT NULL,
+    last_active_height BIGINT NOT NULL DEFAULT 0
 );
 
+CREATE INDEX account_last_active_height_index ON account (last_active_height DESC, address DESC);
+
 CREATE TABLE account_balance(
     address TEXT UNIQUE PRIMARY KEY NOT NULL REFERENCES account(address),
     balance BIGINT NOT NULL,
@@ -11,6 +14,22 @@ CREATE TABLE account_balance(
     height BIGINT NOT NULL
 );
 
+CREATE TABLE account_balance_history(
+    address TEXT NOT NULL REFERENCES account(address),
+    balance BIGINT NOT NULL,
+    code TEXT NOT NULL,
+    contract_map JSONB,
+    height BIGINT NOT NULL,
+    PRIMARY KEY (address,height)
+);
+
+CREATE INDEX account_balance_history_height_index ON account_balance_history (height);
+
+CREATE VIEW account_balance_latest AS
+SELECT DISTINCT ON (address) *
+FROM account_balance_history
+ORDER BY address, height DESC;
+
 CREATE TABLE locked_account
 (
     address TEXT  NOT NULL NOT NULL UNIQUE REFERENCES account(address),
@@ -27,6 +46,11 @@ CREATE TABLE locked_account_balance(
 
 CREATE INDEX locked_account_balance_index ON locked_account_balance (height);
 
+CREATE VIEW locked_account_balance_latest AS
+SELECT DISTINCT ON (locked_address) *
+FROM locked_account_balance
+ORDER BY locked_address, height DESC;
+
 
 CREATE TABLE delegator_account(
     account_address TEXT NOT NULL REFERENCES account(address),
@@ -38,7 +62,7 @@ CREATE TABLE delegator_account(
 
 CREATE TABLE account_key_list( 
   address TEXT  NOT NULL REFERENCES account(address),
-  index BIGINT NOT NULL UNIQUE,
+  index BIGINT NOT NULL,
   weight TEXT  NOT NULL ,
   revoked BOOLEAN  NOT NULL ,
   sig_algo TEXT  NOT NULL ,
@@ -48,7 +72,81 @@ CREATE TABLE account_key_list(
   PRIMARY KEY (address,index)
 );
 
+CREATE TABLE account_key_list_history(
+  address TEXT  NOT NULL REFERENCES account(address),
+  index BIGINT NOT NULL,
+  weight TEXT  NOT NULL ,
+  revoked BOOLEAN  NOT NULL ,
+  sig_algo TEXT  NOT NULL ,
+  hash_algo TEXT  NOT NULL ,
+  public_key TEXT  NOT NULL ,
+  sequence_number BIGINT  NOT NULL,
+  height BIGINT NOT NULL,
+  PRIMARY KEY (address,index,height)
+);
+
+CREATE INDEX account_key_list_history_height_index ON account_key_list_history (height);
+
+CREATE VIEW account_key_list_latest AS
+SELECT DISTINCT ON (address, index) *
+FROM account_key_list_history
+ORDER BY address, index, height DESC;
+
 CREATE TABLE staker_node_id(
     address TEXT  NOT NULL REFERENCES account(address),
     node_id TEXT NOT NULL UNIQUE REFERENCES staking_table (node_id)
-);
\ No newline at end of file
+);
+
+CREATE TABLE account_creation
+(
+    address        TEXT   NOT NULL PRIMARY KEY REFERENCES account (address),
+    creator        TEXT,
+    transaction_id TEXT   NOT NULL,
+    height         BIGINT NOT NULL
+);
+
+CREATE INDEX account_creation_height_index ON account_creation (height);
+CREATE INDEX account_creation_creator_index ON account_creation (creator);
+
+
+CREATE TABLE account_key_history
+(
+    address                TEXT   NOT NULL,
+    index                  BIGINT NOT NULL,
+    public_key             TEXT   NOT NULL,
+    sig_algo               TEXT   NOT NULL,
+    hash_algo              TEXT   NOT NULL,
+    weight                 BIGINT NOT NULL,
+    added_height           BIGINT,
+    added_transaction_id   TEXT,
+    revoked_height         BIGINT,
+    revoked_transaction_id TEXT,
+
+    -- Heights of the refreshes that first observed the key or its revocation when no event is known for them
+    added_observed_height   BIGINT,
+    revoked_observed_height BIGINT,
+    PRIMARY KEY (address, index)
+);
+
+CREATE INDEX account_key_history_public_key_index ON account_key_history (public_key);
+CREATE INDEX account_key_history_added_height_index ON account_key_history (added_height);
+CREATE INDEX account_key_history_revoked_height_index ON account_key_history (revoked_height);
+
+CREATE TABLE account_storage
+(
+    address  TEXT   NOT NULL REFERENCES account (address),
+    used     BIGINT NOT NULL,
+    capacity BIGINT NOT NULL,
+    height   BIGINT NOT NULL,
+    PRIMARY KEY (address, height)
+);
+
+CREATE INDEX account_storage_height_index ON account_storage (height);
+
+CREATE TABLE account_refresh_cursor
+(
+    one_row_id         BOOL   NOT NULL DEFAULT TRUE PRIMARY KEY,
+    address            TEXT   NOT NULL,
+    last_active_height BIGINT NOT NULL,
+    CHECK (one_row_id)
+);
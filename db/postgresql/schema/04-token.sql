@@ -5,3 +5,41 @@ CREATE TABLE supply(
 );
 
 CREATE INDEX supply_height_index ON supply (height);
+
+
+CREATE TABLE supply_history
+(
+  height BIGINT NOT NULL PRIMARY KEY,
+  supply BIGINT NOT NULL
+);
+
+
+CREATE TABLE token_transfer
+(
+  transaction_id TEXT   NOT NULL REFERENCES collection (transaction_id),
+  height         BIGINT NOT NULL REFERENCES block (height),
+  transfer_index BIGINT NOT NULL,
+  token          TEXT   NOT NULL,
+  "from"         TEXT,
+  "to"           TEXT,
+  amount         BIGINT NOT NULL,
+  PRIMARY KEY (transaction_id, transfer_index)
+);
+
+CREATE INDEX token_transfer_height_index ON token_transfer (height);
+CREATE INDEX token_transfer_token_index ON token_transfer (token, height DESC);
+CREATE INDEX token_transfer_from_index ON token_transfer ("from", height DESC);
+CREATE INDEX token_transfer_to_index ON token_transfer ("to", height DESC);
+
+
+CREATE TABLE account_token_balance
+(
+  address TEXT   NOT NULL,
+  token   TEXT   NOT NULL,
+  balance BIGINT NOT NULL,
+  height  BIGINT NOT NULL,
+  PRIMARY KEY (address, token, height)
+);
+
+CREATE INDEX account_token_balance_height_index ON account_token_balance (height);
+CREATE INDEX account_token_balance_token_index ON account_token_balance (token, height DESC);
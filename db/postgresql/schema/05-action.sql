@@ -0,0 +1,17 @@
+CREATE TABLE transaction_action
+(
+    transaction_id TEXT   NOT NULL PRIMARY KEY REFERENCES collection (transaction_id),
+    height         BIGINT NOT NULL REFERENCES block (height),
+    action         TEXT   NOT NULL,
+    "from"         TEXT,
+    "to"           TEXT,
+    amount         BIGINT,
+    node_id        TEXT
+);
+
+COMMENT ON COLUMN transaction_action.amount IS 'Amount of tokens moved by the action, as the raw UFix64 integer (1 FLOW = 100000000)';
+
+CREATE INDEX transaction_action_height_index ON transaction_action (height);
+CREATE INDEX transaction_action_action_index ON transaction_action (action);
+CREATE INDEX transaction_action_from_index ON transaction_action ("from");
+CREATE INDEX transaction_action_to_index ON transaction_action ("to");
@@ -0,0 +1,46 @@
+CREATE TABLE nft_transfer
+(
+  transaction_id TEXT   NOT NULL REFERENCES collection (transaction_id),
+  height         BIGINT NOT NULL REFERENCES block (height),
+  transfer_index BIGINT NOT NULL,
+  contract       TEXT   NOT NULL,
+  token_id       NUMERIC NOT NULL,
+  "from"         TEXT,
+  "to"           TEXT,
+  PRIMARY KEY (transaction_id, transfer_index)
+);
+
+CREATE INDEX nft_transfer_height_index ON nft_transfer (height);
+CREATE INDEX nft_transfer_token_index ON nft_transfer (contract, token_id, height DESC);
+CREATE INDEX nft_transfer_from_index ON nft_transfer ("from", height DESC);
+CREATE INDEX nft_transfer_to_index ON nft_transfer ("to", height DESC);
+
+
+CREATE TABLE nft_owner
+(
+  contract TEXT    NOT NULL,
+  token_id NUMERIC NOT NULL,
+  owner    TEXT,
+  height   BIGINT  NOT NULL,
+  PRIMARY KEY (contract, token_id)
+);
+
+CREATE INDEX nft_owner_owner_index ON nft_owner (owner);
+
+
+CREATE TABLE nft_metadata
+(
+  contract     TEXT    NOT NULL,
+  token_id     NUMERIC NOT NULL,
+  owner        TEXT    NOT NULL,
+  name         TEXT,
+  description  TEXT,
+  thumbnail    TEXT,
+  external_url TEXT,
+  height       BIGINT  NOT NULL,
+  attempts     INT     NOT NULL DEFAULT 1,
+  error        TEXT,
+  PRIMARY KEY (contract, token_id)
+);
+
+CREATE INDEX nft_metadata_failed_index ON nft_metadata (attempts) WHERE error IS NOT NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE contract_version
+(
+  address        TEXT   NOT NULL,
+  name           TEXT   NOT NULL,
+  action         TEXT   NOT NULL,
+  code_hash      TEXT   NOT NULL,
+  source         TEXT,
+  transaction_id TEXT   NOT NULL REFERENCES collection (transaction_id),
+  height         BIGINT NOT NULL REFERENCES block (height),
+  event_index    BIGINT NOT NULL,
+  PRIMARY KEY (transaction_id, event_index)
+);
+
+CREATE INDEX contract_version_contract_index ON contract_version (address, name, height DESC);
+CREATE INDEX contract_version_code_hash_index ON contract_version (code_hash);
@@ -0,0 +1,25 @@
+CREATE TABLE transaction_fee
+(
+  transaction_id   TEXT   NOT NULL PRIMARY KEY REFERENCES collection (transaction_id),
+  height           BIGINT NOT NULL REFERENCES block (height),
+  payer            TEXT   NOT NULL,
+  amount           BIGINT NOT NULL,
+  inclusion_effort BIGINT NOT NULL,
+  execution_effort BIGINT NOT NULL
+);
+
+CREATE INDEX transaction_fee_height_index ON transaction_fee (height);
+CREATE INDEX transaction_fee_payer_index ON transaction_fee (payer, height DESC);
+
+
+CREATE TABLE transaction_fee_daily
+(
+  payer            TEXT   NOT NULL,
+  date             DATE   NOT NULL,
+  amount           BIGINT NOT NULL,
+  execution_effort BIGINT NOT NULL,
+  transactions     BIGINT NOT NULL,
+  PRIMARY KEY (payer, date)
+);
+
+CREATE INDEX transaction_fee_daily_date_index ON transaction_fee_daily (date);
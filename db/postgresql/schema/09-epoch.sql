@@ -0,0 +1,25 @@
+CREATE TABLE epoch
+(
+  counter       BIGINT NOT NULL PRIMARY KEY,
+  start_height  BIGINT,
+  end_height    BIGINT,
+  first_view    BIGINT,
+  final_view    BIGINT,
+  random_source TEXT,
+  nodes         JSONB,
+  setup         JSONB,
+  commit        JSONB
+);
+
+CREATE INDEX epoch_height_index ON epoch (start_height, end_height);
+
+CREATE TABLE epoch_phase
+(
+  counter   BIGINT                      NOT NULL,
+  phase     TEXT                        NOT NULL,
+  height    BIGINT                      NOT NULL,
+  timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+  PRIMARY KEY (counter, phase)
+);
+
+CREATE INDEX epoch_phase_height_index ON epoch_phase (height);
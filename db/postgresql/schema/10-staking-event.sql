@@ -0,0 +1,28 @@
+CREATE TABLE staking_event
+(
+  transaction_id TEXT   NOT NULL,
+  event_index    BIGINT NOT NULL,
+  height         BIGINT NOT NULL REFERENCES block (height),
+  type           TEXT   NOT NULL,
+  node_id        TEXT REFERENCES staking_table (node_id),
+  delegator_id   BIGINT,
+  amount         NUMERIC,
+  fields         JSONB  NOT NULL,
+  PRIMARY KEY (transaction_id, event_index)
+);
+
+CREATE INDEX staking_event_node_index ON staking_event (node_id, delegator_id, height DESC);
+CREATE INDEX staking_event_type_index ON staking_event (type, height DESC);
+
+/* Resolves the account owning each node or delegator, when it is known */
+CREATE VIEW staking_event_account AS
+SELECT staking_event.*,
+       COALESCE(delegator_account.account_address, staker_node_id.address) AS account_address
+FROM staking_event
+       LEFT JOIN delegator_account
+                 ON staking_event.delegator_id IS NOT NULL
+                   AND delegator_account.delegator_node_id = staking_event.node_id
+                   AND delegator_account.delegator_id = staking_event.delegator_id
+       LEFT JOIN staker_node_id
+                 ON staking_event.delegator_id IS NULL
+                   AND staker_node_id.node_id = staking_event.node_id;
@@ -0,0 +1,35 @@
+CREATE TABLE node_epoch_reward
+(
+  epoch           BIGINT           NOT NULL,
+  node_id         TEXT             NOT NULL REFERENCES staking_table (node_id),
+  tokens_staked   NUMERIC          NOT NULL,
+  computed_reward NUMERIC          NOT NULL,
+  paid_reward     NUMERIC,
+  reconciled      BOOLEAN          NOT NULL,
+  apy             DOUBLE PRECISION NOT NULL,
+  PRIMARY KEY (epoch, node_id)
+);
+
+CREATE INDEX node_epoch_reward_node_index ON node_epoch_reward (node_id, epoch DESC);
+
+CREATE TABLE delegator_epoch_reward
+(
+  epoch           BIGINT           NOT NULL,
+  node_id         TEXT             NOT NULL REFERENCES staking_table (node_id),
+  delegator_id    BIGINT           NOT NULL,
+  tokens_staked   NUMERIC          NOT NULL,
+  computed_reward NUMERIC          NOT NULL,
+  paid_reward     NUMERIC,
+  reconciled      BOOLEAN          NOT NULL,
+  apy             DOUBLE PRECISION NOT NULL,
+  PRIMARY KEY (epoch, node_id, delegator_id)
+);
+
+CREATE INDEX delegator_epoch_reward_delegator_index ON delegator_epoch_reward (node_id, delegator_id, epoch DESC);
+
+/* Number of times the rewards of each epoch have been computed without finding their payment */
+CREATE TABLE epoch_reward_attempt
+(
+  epoch    BIGINT NOT NULL PRIMARY KEY,
+  attempts INT    NOT NULL
+);
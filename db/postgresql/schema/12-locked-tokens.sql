@@ -0,0 +1,14 @@
+CREATE TABLE locked_tokens_event
+(
+    transaction_id TEXT   NOT NULL REFERENCES collection (transaction_id),
+    event_index    BIGINT NOT NULL,
+    height         BIGINT NOT NULL REFERENCES block (height),
+    type           TEXT   NOT NULL,
+    address        TEXT   NOT NULL,
+    node_id        TEXT,
+    amount         NUMERIC,
+    new_limit      NUMERIC,
+    PRIMARY KEY (transaction_id, event_index)
+);
+
+CREATE INDEX locked_tokens_event_address_index ON locked_tokens_event (address, height DESC);
@@ -10,6 +10,10 @@ import (
 
 // SaveStakeRequirements save the stake requirement from cadence call
 func (db *Db) SaveStakeRequirements(stakeRequirements []types.StakeRequirements) error {
+	if len(stakeRequirements) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO stake_requirements(height,role,requirements) VALUES `
 
 	var params []interface{}
@@ -34,6 +38,10 @@ func (db *Db) SaveStakeRequirements(stakeRequirements []types.StakeRequirements)
 }
 
 func (db *Db) SaveTotalStakeByType(totalStake []types.TotalStakeByType) error {
+	if len(totalStake) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO total_stake_by_type(height,role,total_stake) VALUES `
 
 	var params []interface{}
@@ -72,11 +80,20 @@ func (db *Db) SaveTotalStake(totalStake types.TotalStake) error {
 }
 
 func (db *Db) SaveStakingTable(stakingTable types.StakingTable) error {
+	return db.saveStakingNodeIDs(stakingTable.StakingTable)
+}
+
+// saveStakingNodeIDs stores the given node ids inside the staking_table, if they are not already present
+func (db *Db) saveStakingNodeIDs(nodeIDs []string) error {
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO staking_table(node_id) VALUES `
 
 	var params []interface{}
 
-	for i, rows := range stakingTable.StakingTable {
+	for i, rows := range nodeIDs {
 		ai := i * 1
 		stmt += fmt.Sprintf("($%d),", ai+1)
 
@@ -95,6 +112,10 @@ func (db *Db) SaveStakingTable(stakingTable types.StakingTable) error {
 }
 
 func (db *Db) SaveProposedTable(proposedTable types.ProposedTable) error {
+	if len(proposedTable.ProposedTable) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO proposed_table(height,proposed_table) VALUES`
 
 	var params []interface{}
@@ -117,6 +138,10 @@ func (db *Db) SaveProposedTable(proposedTable types.ProposedTable) error {
 }
 
 func (db *Db) SaveCurrentTable(currentTable types.CurrentTable) error {
+	if len(currentTable.Table) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO current_table(height,node_id) VALUES `
 
 	var params []interface{}
@@ -139,6 +164,10 @@ func (db *Db) SaveCurrentTable(currentTable types.CurrentTable) error {
 }
 
 func (db *Db) SaveNodeTotalCommitment(nodeTotalCommitment []types.NodeTotalCommitment) error {
+	if len(nodeTotalCommitment) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO node_total_commitment(node_id,total_commitment,height) VALUES `
 
 	var params []interface{}
@@ -162,6 +191,10 @@ func (db *Db) SaveNodeTotalCommitment(nodeTotalCommitment []types.NodeTotalCommi
 }
 
 func (db *Db) SaveNodeTotalCommitmentWithoutDelegators(nodeTotalCommitmentWithoutDelegators []types.NodeTotalCommitmentWithoutDelegators) error {
+	if len(nodeTotalCommitmentWithoutDelegators) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO node_total_commitment_without_delegators(node_id,total_commitment_without_delegators,height) VALUES `
 
 	var params []interface{}
@@ -185,6 +218,10 @@ func (db *Db) SaveNodeTotalCommitmentWithoutDelegators(nodeTotalCommitmentWithou
 }
 
 func (db *Db) SaveNodeInfosFromTable(nodeInfosFromTable []types.StakerNodeInfo, height uint64) error {
+	if len(nodeInfosFromTable) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO node_infos_from_table(id,role,networking_address,networking_key,staking_key,tokens_staked,tokens_committed,tokens_unstaking,tokens_unstaked,tokens_rewarded,delegators,delegator_i_d_counter,tokens_requested_to_unstake,initial_weight,height) VALUES `
 
 	var params []interface{}
@@ -215,6 +252,10 @@ func (db *Db) SaveCutPercentage(cutPercentage types.CutPercentage) error {
 }
 
 func (db *Db) SaveDelegatorInfo(delegatorInfo []types.DelegatorNodeInfo, height uint64) error {
+	if len(delegatorInfo) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO delegator_info(id,node_id,tokens_committed,tokens_staked,tokens_unstaking,tokens_rewarded,tokens_unstaked,tokens_requested_to_unstake,height) VALUES `
 
 	var params []interface{}
@@ -237,6 +278,10 @@ func (db *Db) SaveDelegatorInfo(delegatorInfo []types.DelegatorNodeInfo, height
 }
 
 func (db *Db) SaveNodeUnstakingTokens(nodeUnstakingTokens []types.NodeUnstakingTokens) error {
+	if len(nodeUnstakingTokens) == 0 {
+		return nil
+	}
+
 	stmt := `INSERT INTO node_unstaking_tokens(node_id,token_unstaking,height) VALUES `
 
 	var params []interface{}
@@ -0,0 +1,60 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveStakingEvents stores the given staking events, storing the nodes they refer to as well
+func (db *Db) SaveStakingEvents(events []types.StakingEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var nodeIDs []string
+	for _, event := range events {
+		if event.NodeID != "" {
+			nodeIDs = append(nodeIDs, event.NodeID)
+		}
+	}
+
+	err := db.SaveStakingTable(types.NewStakingTable(int64(events[0].Height), nodeIDs))
+	if err != nil {
+		return fmt.Errorf("error while saving staking event nodes: %s", err)
+	}
+
+	stmt := `
+INSERT INTO staking_event(transaction_id,event_index,height,type,node_id,delegator_id,amount,fields) VALUES `
+
+	var params []interface{}
+	for i, event := range events {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		var delegatorID interface{}
+		if event.DelegatorID != nil {
+			delegatorID = *event.DelegatorID
+		}
+
+		params = append(params,
+			event.TransactionID,
+			event.EventIndex,
+			event.Height,
+			event.Type,
+			nullString(event.NodeID),
+			delegatorID,
+			nullUint64(event.Amount),
+			string(event.Fields),
+		)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (transaction_id, event_index) DO NOTHING`
+
+	_, err = db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving staking events: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package postgresql_test
+
+import (
+	"database/sql"
+
+	"github.com/onflow/flow-go-sdk"
+
+	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveStakingEvents() {
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	nodeID := "2cfab7e9163475282f67186b06ce6eea7fa0687d25dd9c7a84532f2016bc2e5e"
+	suite.AddAccount("0x1")
+	err = suite.database.SaveDelegatorAccounts([]types.DelegatorAccount{
+		types.NewDelegatorAccount("0x1", 3, nodeID),
+	})
+	suite.Require().NoError(err)
+
+	delegatorID := uint32(3)
+	amount := uint64(100000000)
+	events := []types.StakingEvent{
+		types.NewStakingEvent(txID.String(), 0, block.Height, "TokensCommitted", nodeID, nil, &amount,
+			[]byte(`{"nodeID":"`+nodeID+`","amount":"1.00000000"}`)),
+		types.NewStakingEvent(txID.String(), 1, block.Height, "DelegatorTokensCommitted", nodeID, &delegatorID, &amount,
+			[]byte(`{"nodeID":"`+nodeID+`","delegatorID":3,"amount":"1.00000000"}`)),
+		types.NewStakingEvent(txID.String(), 2, block.Height, "NewDelegatorCutPercentage", "", nil, nil,
+			[]byte(`{"newCutPercentage":"0.08000000"}`)),
+	}
+
+	err = suite.database.SaveStakingEvents(events)
+	suite.Require().NoError(err)
+
+	// Saving the same events twice should not fail
+	err = suite.database.SaveStakingEvents(events)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.StakingEventRow{
+		dbtypes.NewStakingEventRow(txID.String(), 0, block.Height, "TokensCommitted",
+			sql.NullString{String: nodeID, Valid: true}, sql.NullInt64{}, sql.NullInt64{Int64: 100000000, Valid: true}),
+		dbtypes.NewStakingEventRow(txID.String(), 1, block.Height, "DelegatorTokensCommitted",
+			sql.NullString{String: nodeID, Valid: true}, sql.NullInt64{Int64: 3, Valid: true}, sql.NullInt64{Int64: 100000000, Valid: true}),
+		dbtypes.NewStakingEventRow(txID.String(), 2, block.Height, "NewDelegatorCutPercentage",
+			sql.NullString{}, sql.NullInt64{}, sql.NullInt64{}),
+	}
+
+	var rows []dbtypes.StakingEventRow
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT transaction_id, event_index, height, type, node_id, delegator_id, amount FROM staking_event ORDER BY event_index`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+
+	var address string
+	err = suite.database.Sqlx.QueryRow(
+		`SELECT account_address FROM staking_event_account WHERE event_index = 1`).Scan(&address)
+	suite.Require().NoError(err)
+	suite.Require().Equal("0x1", address)
+}
@@ -417,3 +417,38 @@ func (suite *DbTestSuite) TestBigDipperDb_DelegatorInfo() {
 	suite.Require().Len(outputs, 1, "should contain only one row")
 	suite.Require().True(expectedRow.Equal(outputs[0]))
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_NodeUnstakingTokens() {
+
+	// ------------------------------
+	// --- Prepare the data
+	// ------------------------------
+
+	input := []types.NodeUnstakingTokens{
+		types.NewNodeUnstakingTokens("0x1", 100000008, 1),
+	}
+
+	err := suite.InsertIntoStakingTable(1, "0x1")
+	suite.Require().NoError(err)
+
+	// ------------------------------
+	// --- Save the data
+	// ------------------------------
+
+	err = suite.database.SaveNodeUnstakingTokens(input)
+	suite.Require().NoError(err)
+
+	// Saving an empty list should not fail
+	err = suite.database.SaveNodeUnstakingTokens(nil)
+	suite.Require().NoError(err)
+
+	// ------------------------------
+	// --- Verify the data
+	// ------------------------------
+	expectedRow := dbtypes.NewNodeUnstakingTokensRow("0x1", 100000008, 1)
+	var outputs []dbtypes.NodeUnstakingTokensRow
+	err = suite.database.Sqlx.Select(&outputs, `SELECT * FROM node_unstaking_tokens`)
+	suite.Require().NoError(err)
+	suite.Require().Len(outputs, 1, "should contain only one row")
+	suite.Require().True(expectedRow.Equal(outputs[0]))
+}
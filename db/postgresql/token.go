@@ -1,12 +1,78 @@
 package postgresql
 
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// SaveSupply stores the given total supply inside the supply_history table,
+// and updates the latest value stored inside the supply table
 func (db *Db) SaveSupply(supply uint64, height uint64) error {
-	stmt := `INSERT INTO supply(height,supply) VALUES ($1,$2) ON CONFLICT (one_row_id) DO UPDATE 
+	stmt := `INSERT INTO supply_history(height,supply) VALUES ($1,$2) ON CONFLICT (height) DO UPDATE 
+    SET supply = excluded.supply`
+	_, err := db.Sql.Exec(stmt, height, supply)
+	if err != nil {
+		return fmt.Errorf("error while storing supply history: %s", err)
+	}
+
+	stmt = `INSERT INTO supply(height,supply) VALUES ($1,$2) ON CONFLICT (one_row_id) DO UPDATE 
     SET supply = excluded.supply,
     	height = excluded.height
 WHERE supply.height <= excluded.height;`
-	_, err := db.Sql.Exec(stmt,
+	_, err = db.Sql.Exec(stmt,
 		height,
 		supply)
 	return err
 }
+
+// SaveTokenTransfers stores the given fungible token transfers
+func (db *Db) SaveTokenTransfers(transfers []types.TokenTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO token_transfer(transaction_id,height,transfer_index,token,"from","to",amount) VALUES `
+
+	var params []interface{}
+	for i, transfer := range transfers {
+		ai := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7)
+		params = append(params, transfer.TransactionID, transfer.Height, transfer.Index, transfer.Token,
+			nullString(transfer.From), nullString(transfer.To), transfer.Amount)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT DO NOTHING`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving token transfers: %s", err)
+	}
+
+	return nil
+}
+
+// SaveAccountTokenBalances stores the given fungible token balances
+func (db *Db) SaveAccountTokenBalances(balances []types.AccountTokenBalance) error {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account_token_balance(address,token,balance,height) VALUES `
+
+	var params []interface{}
+	for i, balance := range balances {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params, balance.Address, balance.Token, balance.Balance, balance.Height)
+	}
+	stmt = stmt[:len(stmt)-1]
+	stmt += ` ON CONFLICT (address,token,height) DO UPDATE SET balance = excluded.balance`
+
+	_, err := db.Sqlx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while saving account token balances: %s", err)
+	}
+
+	return nil
+}
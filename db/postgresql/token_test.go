@@ -1,7 +1,10 @@
 package postgresql_test
 
 import (
+	"github.com/onflow/flow-go-sdk"
+
 	dbtypes "github.com/HarleyAppleChoi/junomum/db/types"
+	"github.com/HarleyAppleChoi/junomum/types"
 )
 
 func (suite *DbTestSuite) TestSaveConsensus_SaveSupply() {
@@ -61,3 +64,102 @@ func (suite *DbTestSuite) TestSaveConsensus_SaveSupply() {
 	suite.Require().Len(rows, 1)
 	suite.Require().True(rows[0].Equal(expected), "updating with higher height should change the data")
 }
+
+func (suite *DbTestSuite) TestSaveConsensus_SaveSupplyHistory() {
+	err := suite.database.SaveSupply(20, 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveSupply(30, 15)
+	suite.Require().NoError(err)
+
+	// Saving an older value should still be added to the history
+	err = suite.database.SaveSupply(10, 5)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.SupplyHistoryRow{
+		dbtypes.NewSupplyHistoryRow(5, 10),
+		dbtypes.NewSupplyHistoryRow(10, 20),
+		dbtypes.NewSupplyHistoryRow(15, 30),
+	}
+
+	var rows []dbtypes.SupplyHistoryRow
+	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM supply_history ORDER BY height")
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+
+	var latest []dbtypes.SupplyRow
+	err = suite.database.Sqlx.Select(&latest, "SELECT * FROM supply")
+	suite.Require().NoError(err)
+	suite.Require().Len(latest, 1)
+	suite.Require().True(latest[0].Equal(dbtypes.NewSupplyRow(15, 30)))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTokenTransfers() {
+	block := suite.getBlock(10)
+	txID := flow.HexToID("0x6")
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, []flow.Identifier{txID}),
+	})
+	suite.Require().NoError(err)
+
+	token := "A.1654653399040a61.FlowToken"
+	transfers := []types.TokenTransfer{
+		types.NewTokenTransfer(txID.String(), block.Height, 0, token, "0000000000000001", "0000000000000002", 7),
+		types.NewTokenTransfer(txID.String(), block.Height, 1, token, "", "0000000000000002", 5),
+	}
+
+	err = suite.database.SaveTokenTransfers(transfers)
+	suite.Require().NoError(err)
+
+	// Saving the same transfers twice should not fail
+	err = suite.database.SaveTokenTransfers(transfers)
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.TokenTransferRow{
+		dbtypes.NewTokenTransferRow(txID.String(), block.Height, 0, token, "0000000000000001", "0000000000000002", 7),
+		dbtypes.NewTokenTransferRow(txID.String(), block.Height, 1, token, "", "0000000000000002", 5),
+	}
+
+	var rows []dbtypes.TokenTransferRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM token_transfer ORDER BY transfer_index`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountTokenBalances() {
+	fusd := "A.3c5959b568896393.FUSD"
+	flowToken := "A.1654653399040a61.FlowToken"
+
+	err := suite.database.SaveAccountTokenBalances([]types.AccountTokenBalance{
+		types.NewAccountTokenBalance("0000000000000001", flowToken, 100, 10),
+		types.NewAccountTokenBalance("0000000000000001", fusd, 50, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Update one of the balances at the same height, and the other one at a later height
+	err = suite.database.SaveAccountTokenBalances([]types.AccountTokenBalance{
+		types.NewAccountTokenBalance("0000000000000001", flowToken, 90, 10),
+		types.NewAccountTokenBalance("0000000000000001", fusd, 60, 11),
+	})
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.AccountTokenBalanceRow{
+		dbtypes.NewAccountTokenBalanceRow("0000000000000001", flowToken, 90, 10),
+		dbtypes.NewAccountTokenBalanceRow("0000000000000001", fusd, 50, 10),
+		dbtypes.NewAccountTokenBalanceRow("0000000000000001", fusd, 60, 11),
+	}
+
+	var rows []dbtypes.AccountTokenBalanceRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_token_balance ORDER BY token, height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, len(expected))
+	for i, row := range rows {
+		suite.Require().True(row.Equal(expected[i]))
+	}
+}
@@ -0,0 +1,80 @@
+package postgresql_test
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTxs() {
+	// ------------------------------
+	// --- Prepare the data
+	// ------------------------------
+
+	block := suite.getBlock(10)
+	txIDs := []flow.Identifier{flow.HexToID("0x6"), flow.HexToID("0x7")}
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, txIDs),
+	})
+	suite.Require().NoError(err)
+
+	script := []byte("transaction(amount: UFix64) {}")
+	arguments := [][]byte{[]byte(`{"type":"UFix64","value":"1000.00000000"}`)}
+
+	txs := types.Txs{
+		types.NewTx(block.Height, txIDs[0].String(), script, arguments, "0x2", 100, "0x1", "0x1", []string{"0x1"}, []byte("[]"), []byte("[]")),
+		types.NewTx(block.Height, txIDs[1].String(), script, arguments, "0x2", 100, "0x1", "0x1", []string{"0x1"}, []byte("[]"), []byte("[]")),
+	}
+
+	// ------------------------------
+	// --- Save the data
+	// ------------------------------
+
+	err = suite.database.SaveTxs(txs)
+	suite.Require().NoError(err)
+
+	// ------------------------------
+	// --- Verify the data
+	// ------------------------------
+
+	var scripts int
+	err = suite.database.Sqlx.QueryRow(`SELECT COUNT(*) FROM script`).Scan(&scripts)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, scripts, "the same script should be stored only once")
+
+	var count int
+	err = suite.database.Sqlx.QueryRow(`
+SELECT COUNT(*) FROM transaction 
+WHERE script_hash = $1 AND (arguments->0->>'value')::NUMERIC > 999`, txs[0].ScriptHash()).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountTransactions() {
+	block := suite.getBlock(10)
+	txIDs := []flow.Identifier{flow.HexToID("0x6"), flow.HexToID("0x7")}
+	err := suite.database.SaveCollection([]types.Collection{
+		types.NewCollection(block.Height, "0x3", true, txIDs),
+	})
+	suite.Require().NoError(err)
+
+	relations := []types.AccountTransaction{
+		types.NewAccountTransaction("8d0e87b65159ae63", txIDs[0].String(), block.Height, types.AccountTransactionRolePayer),
+		types.NewAccountTransaction("8d0e87b65159ae63", txIDs[0].String(), block.Height, types.AccountTransactionRoleAuthorizer),
+		types.NewAccountTransaction("8d0e87b65159ae63", txIDs[1].String(), block.Height, types.AccountTransactionRolePayer),
+		types.NewAccountTransaction("1654653399040a61", txIDs[1].String(), block.Height, types.AccountTransactionRoleEvent),
+	}
+
+	err = suite.database.SaveAccountTransactions(relations)
+	suite.Require().NoError(err)
+
+	// Saving the same relations twice should not fail
+	err = suite.database.SaveAccountTransactions(relations)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.Sqlx.QueryRow(`
+SELECT COUNT(DISTINCT transaction_id) FROM account_transaction WHERE address = $1`, "8d0e87b65159ae63").Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+}
@@ -0,0 +1,8 @@
+package types
+
+// AccountActivityRow represents a single row of the account_refresh_cursor table,
+// as well as the latest activity of an account
+type AccountActivityRow struct {
+	Address          string `db:"address"`
+	LastActiveHeight int64  `db:"last_active_height"`
+}
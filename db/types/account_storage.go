@@ -0,0 +1,27 @@
+package types
+
+// AccountStorageRow represents a single row of the account_storage table
+type AccountStorageRow struct {
+	Address  string `db:"address"`
+	Used     uint64 `db:"used"`
+	Capacity uint64 `db:"capacity"`
+	Height   uint64 `db:"height"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountStorageRow) Equal(w AccountStorageRow) bool {
+	return v.Address == w.Address &&
+		v.Used == w.Used &&
+		v.Capacity == w.Capacity &&
+		v.Height == w.Height
+}
+
+// NewAccountStorageRow allows to build a new AccountStorageRow
+func NewAccountStorageRow(address string, used uint64, capacity uint64, height uint64) AccountStorageRow {
+	return AccountStorageRow{
+		Address:  address,
+		Used:     used,
+		Capacity: capacity,
+		Height:   height,
+	}
+}
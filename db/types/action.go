@@ -0,0 +1,46 @@
+package types
+
+import "database/sql"
+
+// TransactionActionRow represents a single row of the transaction_action table
+type TransactionActionRow struct {
+	TransactionID string         `db:"transaction_id"`
+	Height        uint64         `db:"height"`
+	Action        string         `db:"action"`
+	From          sql.NullString `db:"from"`
+	To            sql.NullString `db:"to"`
+	Amount        sql.NullInt64  `db:"amount"`
+	NodeID        sql.NullString `db:"node_id"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TransactionActionRow) Equal(w TransactionActionRow) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Action == w.Action &&
+		v.From == w.From &&
+		v.To == w.To &&
+		v.Amount == w.Amount &&
+		v.NodeID == w.NodeID
+}
+
+// NewTransactionActionRow allows to build a new TransactionActionRow.
+// Empty values are stored as NULL
+func NewTransactionActionRow(
+	transactionID string,
+	height uint64,
+	action string,
+	from string,
+	to string,
+	amount uint64,
+	nodeID string) TransactionActionRow {
+	return TransactionActionRow{
+		TransactionID: transactionID,
+		Height:        height,
+		Action:        action,
+		From:          sql.NullString{String: from, Valid: from != ""},
+		To:            sql.NullString{String: to, Valid: to != ""},
+		Amount:        sql.NullInt64{Int64: int64(amount), Valid: amount != 0},
+		NodeID:        sql.NullString{String: nodeID, Valid: nodeID != ""},
+	}
+}
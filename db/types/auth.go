@@ -1,5 +1,7 @@
 package types
 
+import "database/sql"
+
 // AccountRow represents a single row of the account table
 type AccountRow struct {
 	Address string `db:"address"`
@@ -210,3 +212,99 @@ func NewStakerNodeIdRow(
 		NodeId:  nodeId,
 	}
 }
+
+// AccountCreationRow represents a single row of the account_creation table
+type AccountCreationRow struct {
+	Address       string         `db:"address"`
+	Creator       sql.NullString `db:"creator"`
+	TransactionID string         `db:"transaction_id"`
+	Height        uint64         `db:"height"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountCreationRow) Equal(w AccountCreationRow) bool {
+	return v.Address == w.Address &&
+		v.Creator == w.Creator &&
+		v.TransactionID == w.TransactionID &&
+		v.Height == w.Height
+}
+
+// NewAccountCreationRow allows to build a new AccountCreationRow.
+// An empty creator is stored as NULL
+func NewAccountCreationRow(address string, creator string, transactionID string, height uint64) AccountCreationRow {
+	return AccountCreationRow{
+		Address:       address,
+		Creator:       sql.NullString{String: creator, Valid: creator != ""},
+		TransactionID: transactionID,
+		Height:        height,
+	}
+}
+
+// TransactionPayerRow represents the payer of a single row of the transaction table
+type TransactionPayerRow struct {
+	TransactionID string         `db:"transaction_id"`
+	Payer         sql.NullString `db:"payer"`
+}
+
+// AccountKeyHistoryRow represents a single row of the account_key_history table
+type AccountKeyHistoryRow struct {
+	Address               string         `db:"address"`
+	Index                 int            `db:"index"`
+	PublicKey             string         `db:"public_key"`
+	SigAlgo               string         `db:"sig_algo"`
+	HashAlgo              string         `db:"hash_algo"`
+	Weight                int            `db:"weight"`
+	AddedHeight           sql.NullInt64  `db:"added_height"`
+	AddedTransactionID    sql.NullString `db:"added_transaction_id"`
+	RevokedHeight         sql.NullInt64  `db:"revoked_height"`
+	RevokedTransactionID  sql.NullString `db:"revoked_transaction_id"`
+	AddedObservedHeight   sql.NullInt64  `db:"added_observed_height"`
+	RevokedObservedHeight sql.NullInt64  `db:"revoked_observed_height"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountKeyHistoryRow) Equal(w AccountKeyHistoryRow) bool {
+	return v.Address == w.Address &&
+		v.Index == w.Index &&
+		v.PublicKey == w.PublicKey &&
+		v.SigAlgo == w.SigAlgo &&
+		v.HashAlgo == w.HashAlgo &&
+		v.Weight == w.Weight &&
+		v.AddedHeight == w.AddedHeight &&
+		v.AddedTransactionID == w.AddedTransactionID &&
+		v.RevokedHeight == w.RevokedHeight &&
+		v.RevokedTransactionID == w.RevokedTransactionID &&
+		v.AddedObservedHeight == w.AddedObservedHeight &&
+		v.RevokedObservedHeight == w.RevokedObservedHeight
+}
+
+// NewAccountKeyHistoryRow allows to build a new AccountKeyHistoryRow.
+// Empty heights and transactions are stored as NULL
+func NewAccountKeyHistoryRow(
+	address string,
+	index int,
+	publicKey string,
+	sigAlgo string,
+	hashAlgo string,
+	weight int,
+	addedHeight int64,
+	addedTransactionID string,
+	revokedHeight int64,
+	revokedTransactionID string,
+	addedObservedHeight int64,
+	revokedObservedHeight int64) AccountKeyHistoryRow {
+	return AccountKeyHistoryRow{
+		Address:               address,
+		Index:                 index,
+		PublicKey:             publicKey,
+		SigAlgo:               sigAlgo,
+		HashAlgo:              hashAlgo,
+		Weight:                weight,
+		AddedHeight:           sql.NullInt64{Int64: addedHeight, Valid: addedHeight != 0},
+		AddedTransactionID:    sql.NullString{String: addedTransactionID, Valid: addedTransactionID != ""},
+		RevokedHeight:         sql.NullInt64{Int64: revokedHeight, Valid: revokedHeight != 0},
+		RevokedTransactionID:  sql.NullString{String: revokedTransactionID, Valid: revokedTransactionID != ""},
+		AddedObservedHeight:   sql.NullInt64{Int64: addedObservedHeight, Valid: addedObservedHeight != 0},
+		RevokedObservedHeight: sql.NullInt64{Int64: revokedObservedHeight, Valid: revokedObservedHeight != 0},
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import "database/sql"
+
+// ContractVersionRow represents a single row of the contract_version table
+type ContractVersionRow struct {
+	Address       string         `db:"address"`
+	Name          string         `db:"name"`
+	Action        string         `db:"action"`
+	CodeHash      string         `db:"code_hash"`
+	Source        sql.NullString `db:"source"`
+	TransactionID string         `db:"transaction_id"`
+	Height        uint64         `db:"height"`
+	EventIndex    int            `db:"event_index"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v ContractVersionRow) Equal(w ContractVersionRow) bool {
+	return v.Address == w.Address &&
+		v.Name == w.Name &&
+		v.Action == w.Action &&
+		v.CodeHash == w.CodeHash &&
+		v.Source == w.Source &&
+		v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.EventIndex == w.EventIndex
+}
+
+// NewContractVersionRow allows to build a new ContractVersionRow.
+// An empty source is stored as NULL
+func NewContractVersionRow(
+	address string,
+	name string,
+	action string,
+	codeHash string,
+	source string,
+	transactionID string,
+	height uint64,
+	eventIndex int) ContractVersionRow {
+	return ContractVersionRow{
+		Address:       address,
+		Name:          name,
+		Action:        action,
+		CodeHash:      codeHash,
+		Source:        sql.NullString{String: source, Valid: source != ""},
+		TransactionID: transactionID,
+		Height:        height,
+		EventIndex:    eventIndex,
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+)
+
+// EpochRow represents a single row of the epoch table, without its JSONB columns
+type EpochRow struct {
+	Counter      uint64         `db:"counter"`
+	StartHeight  sql.NullInt64  `db:"start_height"`
+	EndHeight    sql.NullInt64  `db:"end_height"`
+	FirstView    sql.NullInt64  `db:"first_view"`
+	FinalView    sql.NullInt64  `db:"final_view"`
+	RandomSource sql.NullString `db:"random_source"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v EpochRow) Equal(w EpochRow) bool {
+	return v.Counter == w.Counter &&
+		v.StartHeight == w.StartHeight &&
+		v.EndHeight == w.EndHeight &&
+		v.FirstView == w.FirstView &&
+		v.FinalView == w.FinalView &&
+		v.RandomSource == w.RandomSource
+}
+
+// NewEpochRow allows to build a new EpochRow
+func NewEpochRow(
+	counter uint64,
+	startHeight sql.NullInt64,
+	endHeight sql.NullInt64,
+	firstView sql.NullInt64,
+	finalView sql.NullInt64,
+	randomSource sql.NullString) EpochRow {
+	return EpochRow{
+		Counter:      counter,
+		StartHeight:  startHeight,
+		EndHeight:    endHeight,
+		FirstView:    firstView,
+		FinalView:    finalView,
+		RandomSource: randomSource,
+	}
+}
+
+// EpochPhaseRow represents a single row of the epoch_phase table
+type EpochPhaseRow struct {
+	Counter   uint64    `db:"counter"`
+	Phase     string    `db:"phase"`
+	Height    uint64    `db:"height"`
+	Timestamp time.Time `db:"timestamp"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v EpochPhaseRow) Equal(w EpochPhaseRow) bool {
+	return v.Counter == w.Counter &&
+		v.Phase == w.Phase &&
+		v.Height == w.Height &&
+		v.Timestamp.Equal(w.Timestamp)
+}
+
+// NewEpochPhaseRow allows to build a new EpochPhaseRow
+func NewEpochPhaseRow(counter uint64, phase string, height uint64, timestamp time.Time) EpochPhaseRow {
+	return EpochPhaseRow{
+		Counter:   counter,
+		Phase:     phase,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
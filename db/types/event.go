@@ -0,0 +1,11 @@
+package types
+
+// EventRow represents a single row of the event table
+type EventRow struct {
+	Height           int64  `db:"height"`
+	Type             string `db:"type"`
+	TransactionID    string `db:"transaction_id"`
+	TransactionIndex string `db:"transaction_index"`
+	EventIndex       int64  `db:"event_index"`
+	Value            []byte `db:"value"`
+}
@@ -0,0 +1,75 @@
+package types
+
+import "time"
+
+// TransactionFeeRow represents a single row of the transaction_fee table
+type TransactionFeeRow struct {
+	TransactionID   string `db:"transaction_id"`
+	Height          uint64 `db:"height"`
+	Payer           string `db:"payer"`
+	Amount          uint64 `db:"amount"`
+	InclusionEffort uint64 `db:"inclusion_effort"`
+	ExecutionEffort uint64 `db:"execution_effort"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TransactionFeeRow) Equal(w TransactionFeeRow) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Payer == w.Payer &&
+		v.Amount == w.Amount &&
+		v.InclusionEffort == w.InclusionEffort &&
+		v.ExecutionEffort == w.ExecutionEffort
+}
+
+// NewTransactionFeeRow allows to build a new TransactionFeeRow
+func NewTransactionFeeRow(
+	transactionID string,
+	height uint64,
+	payer string,
+	amount uint64,
+	inclusionEffort uint64,
+	executionEffort uint64) TransactionFeeRow {
+	return TransactionFeeRow{
+		TransactionID:   transactionID,
+		Height:          height,
+		Payer:           payer,
+		Amount:          amount,
+		InclusionEffort: inclusionEffort,
+		ExecutionEffort: executionEffort,
+	}
+}
+
+// TransactionFeeDailyRow represents a single row of the transaction_fee_daily table
+type TransactionFeeDailyRow struct {
+	Payer           string    `db:"payer"`
+	Date            time.Time `db:"date"`
+	Amount          uint64    `db:"amount"`
+	ExecutionEffort uint64    `db:"execution_effort"`
+	Transactions    uint64    `db:"transactions"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TransactionFeeDailyRow) Equal(w TransactionFeeDailyRow) bool {
+	return v.Payer == w.Payer &&
+		v.Date.Equal(w.Date) &&
+		v.Amount == w.Amount &&
+		v.ExecutionEffort == w.ExecutionEffort &&
+		v.Transactions == w.Transactions
+}
+
+// NewTransactionFeeDailyRow allows to build a new TransactionFeeDailyRow
+func NewTransactionFeeDailyRow(
+	payer string,
+	date time.Time,
+	amount uint64,
+	executionEffort uint64,
+	transactions uint64) TransactionFeeDailyRow {
+	return TransactionFeeDailyRow{
+		Payer:           payer,
+		Date:            date,
+		Amount:          amount,
+		ExecutionEffort: executionEffort,
+		Transactions:    transactions,
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import "database/sql"
+
+// LockedTokensEventRow represents a single row of the locked_tokens_event table
+type LockedTokensEventRow struct {
+	TransactionID string         `db:"transaction_id"`
+	EventIndex    int            `db:"event_index"`
+	Height        uint64         `db:"height"`
+	Type          string         `db:"type"`
+	Address       string         `db:"address"`
+	NodeID        sql.NullString `db:"node_id"`
+	Amount        sql.NullInt64  `db:"amount"`
+	NewLimit      sql.NullInt64  `db:"new_limit"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v LockedTokensEventRow) Equal(w LockedTokensEventRow) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.EventIndex == w.EventIndex &&
+		v.Height == w.Height &&
+		v.Type == w.Type &&
+		v.Address == w.Address &&
+		v.NodeID == w.NodeID &&
+		v.Amount == w.Amount &&
+		v.NewLimit == w.NewLimit
+}
+
+// NewLockedTokensEventRow allows to build a new LockedTokensEventRow
+func NewLockedTokensEventRow(
+	transactionID string,
+	eventIndex int,
+	height uint64,
+	eventType string,
+	address string,
+	nodeID sql.NullString,
+	amount sql.NullInt64,
+	newLimit sql.NullInt64) LockedTokensEventRow {
+	return LockedTokensEventRow{
+		TransactionID: transactionID,
+		EventIndex:    eventIndex,
+		Height:        height,
+		Type:          eventType,
+		Address:       address,
+		NodeID:        nodeID,
+		Amount:        amount,
+		NewLimit:      newLimit,
+	}
+}
@@ -0,0 +1,128 @@
+package types
+
+import "database/sql"
+
+// NFTTransferRow represents a single row of the nft_transfer table
+type NFTTransferRow struct {
+	TransactionID string         `db:"transaction_id"`
+	Height        uint64         `db:"height"`
+	TransferIndex int            `db:"transfer_index"`
+	Contract      string         `db:"contract"`
+	TokenID       uint64         `db:"token_id"`
+	From          sql.NullString `db:"from"`
+	To            sql.NullString `db:"to"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v NFTTransferRow) Equal(w NFTTransferRow) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.TransferIndex == w.TransferIndex &&
+		v.Contract == w.Contract &&
+		v.TokenID == w.TokenID &&
+		v.From == w.From &&
+		v.To == w.To
+}
+
+// NewNFTTransferRow allows to build a new NFTTransferRow.
+// Empty addresses are stored as NULL
+func NewNFTTransferRow(
+	transactionID string,
+	height uint64,
+	transferIndex int,
+	contract string,
+	tokenID uint64,
+	from string,
+	to string) NFTTransferRow {
+	return NFTTransferRow{
+		TransactionID: transactionID,
+		Height:        height,
+		TransferIndex: transferIndex,
+		Contract:      contract,
+		TokenID:       tokenID,
+		From:          sql.NullString{String: from, Valid: from != ""},
+		To:            sql.NullString{String: to, Valid: to != ""},
+	}
+}
+
+// NFTOwnerRow represents a single row of the nft_owner table
+type NFTOwnerRow struct {
+	Contract string         `db:"contract"`
+	TokenID  uint64         `db:"token_id"`
+	Owner    sql.NullString `db:"owner"`
+	Height   uint64         `db:"height"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v NFTOwnerRow) Equal(w NFTOwnerRow) bool {
+	return v.Contract == w.Contract &&
+		v.TokenID == w.TokenID &&
+		v.Owner == w.Owner &&
+		v.Height == w.Height
+}
+
+// NewNFTOwnerRow allows to build a new NFTOwnerRow.
+// An empty owner is stored as NULL
+func NewNFTOwnerRow(contract string, tokenID uint64, owner string, height uint64) NFTOwnerRow {
+	return NFTOwnerRow{
+		Contract: contract,
+		TokenID:  tokenID,
+		Owner:    sql.NullString{String: owner, Valid: owner != ""},
+		Height:   height,
+	}
+}
+
+// NFTMetadataRow represents a single row of the nft_metadata table
+type NFTMetadataRow struct {
+	Contract    string         `db:"contract"`
+	TokenID     uint64         `db:"token_id"`
+	Owner       string         `db:"owner"`
+	Name        sql.NullString `db:"name"`
+	Description sql.NullString `db:"description"`
+	Thumbnail   sql.NullString `db:"thumbnail"`
+	ExternalURL sql.NullString `db:"external_url"`
+	Height      uint64         `db:"height"`
+	Attempts    int            `db:"attempts"`
+	Error       sql.NullString `db:"error"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v NFTMetadataRow) Equal(w NFTMetadataRow) bool {
+	return v.Contract == w.Contract &&
+		v.TokenID == w.TokenID &&
+		v.Owner == w.Owner &&
+		v.Name == w.Name &&
+		v.Description == w.Description &&
+		v.Thumbnail == w.Thumbnail &&
+		v.ExternalURL == w.ExternalURL &&
+		v.Height == w.Height &&
+		v.Attempts == w.Attempts &&
+		v.Error == w.Error
+}
+
+// NewNFTMetadataRow allows to build a new NFTMetadataRow.
+// Empty values are stored as NULL
+func NewNFTMetadataRow(
+	contract string,
+	tokenID uint64,
+	owner string,
+	name string,
+	description string,
+	thumbnail string,
+	externalURL string,
+	height uint64,
+	attempts int,
+	err string) NFTMetadataRow {
+	return NFTMetadataRow{
+		Contract:    contract,
+		TokenID:     tokenID,
+		Owner:       owner,
+		Name:        sql.NullString{String: name, Valid: name != ""},
+		Description: sql.NullString{String: description, Valid: description != ""},
+		Thumbnail:   sql.NullString{String: thumbnail, Valid: thumbnail != ""},
+		ExternalURL: sql.NullString{String: externalURL, Valid: externalURL != ""},
+		Height:      height,
+		Attempts:    attempts,
+		Error:       sql.NullString{String: err, Valid: err != ""},
+	}
+}
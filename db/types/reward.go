@@ -0,0 +1,120 @@
+package types
+
+import "database/sql"
+
+// EpochRangeRow represents the heights and duration, in seconds, of an ended epoch
+type EpochRangeRow struct {
+	Counter     uint64  `db:"counter"`
+	StartHeight uint64  `db:"start_height"`
+	EndHeight   uint64  `db:"end_height"`
+	Duration    float64 `db:"duration"`
+}
+
+// NodeStakeRow represents the tokens staked by a node inside a node_infos_from_table snapshot
+type NodeStakeRow struct {
+	NodeID       string `db:"node_id"`
+	Role         uint8  `db:"role"`
+	TokensStaked uint64 `db:"tokens_staked"`
+}
+
+// DelegatorStakeRow represents the tokens staked by a delegator inside a delegator_info snapshot
+type DelegatorStakeRow struct {
+	NodeID       string `db:"node_id"`
+	DelegatorID  uint32 `db:"delegator_id"`
+	TokensStaked uint64 `db:"tokens_staked"`
+}
+
+// PaidRewardRow represents the amount of a RewardsPaid or DelegatorRewardsPaid row of the staking_event table
+type PaidRewardRow struct {
+	NodeID      string        `db:"node_id"`
+	DelegatorID sql.NullInt64 `db:"delegator_id"`
+	Amount      uint64        `db:"amount"`
+}
+
+// NodeEpochRewardRow represents a single row of the node_epoch_reward table
+type NodeEpochRewardRow struct {
+	Epoch          uint64        `db:"epoch"`
+	NodeID         string        `db:"node_id"`
+	TokensStaked   uint64        `db:"tokens_staked"`
+	ComputedReward uint64        `db:"computed_reward"`
+	PaidReward     sql.NullInt64 `db:"paid_reward"`
+	Reconciled     bool          `db:"reconciled"`
+	APY            float64       `db:"apy"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v NodeEpochRewardRow) Equal(w NodeEpochRewardRow) bool {
+	return v.Epoch == w.Epoch &&
+		v.NodeID == w.NodeID &&
+		v.TokensStaked == w.TokensStaked &&
+		v.ComputedReward == w.ComputedReward &&
+		v.PaidReward == w.PaidReward &&
+		v.Reconciled == w.Reconciled &&
+		v.APY == w.APY
+}
+
+// NewNodeEpochRewardRow allows to build a new NodeEpochRewardRow
+func NewNodeEpochRewardRow(
+	epoch uint64,
+	nodeID string,
+	tokensStaked uint64,
+	computedReward uint64,
+	paidReward sql.NullInt64,
+	reconciled bool,
+	apy float64) NodeEpochRewardRow {
+	return NodeEpochRewardRow{
+		Epoch:          epoch,
+		NodeID:         nodeID,
+		TokensStaked:   tokensStaked,
+		ComputedReward: computedReward,
+		PaidReward:     paidReward,
+		Reconciled:     reconciled,
+		APY:            apy,
+	}
+}
+
+// DelegatorEpochRewardRow represents a single row of the delegator_epoch_reward table
+type DelegatorEpochRewardRow struct {
+	Epoch          uint64        `db:"epoch"`
+	NodeID         string        `db:"node_id"`
+	DelegatorID    uint32        `db:"delegator_id"`
+	TokensStaked   uint64        `db:"tokens_staked"`
+	ComputedReward uint64        `db:"computed_reward"`
+	PaidReward     sql.NullInt64 `db:"paid_reward"`
+	Reconciled     bool          `db:"reconciled"`
+	APY            float64       `db:"apy"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v DelegatorEpochRewardRow) Equal(w DelegatorEpochRewardRow) bool {
+	return v.Epoch == w.Epoch &&
+		v.NodeID == w.NodeID &&
+		v.DelegatorID == w.DelegatorID &&
+		v.TokensStaked == w.TokensStaked &&
+		v.ComputedReward == w.ComputedReward &&
+		v.PaidReward == w.PaidReward &&
+		v.Reconciled == w.Reconciled &&
+		v.APY == w.APY
+}
+
+// NewDelegatorEpochRewardRow allows to build a new DelegatorEpochRewardRow
+func NewDelegatorEpochRewardRow(
+	epoch uint64,
+	nodeID string,
+	delegatorID uint32,
+	tokensStaked uint64,
+	computedReward uint64,
+	paidReward sql.NullInt64,
+	reconciled bool,
+	apy float64) DelegatorEpochRewardRow {
+	return DelegatorEpochRewardRow{
+		Epoch:          epoch,
+		NodeID:         nodeID,
+		DelegatorID:    delegatorID,
+		TokensStaked:   tokensStaked,
+		ComputedReward: computedReward,
+		PaidReward:     paidReward,
+		Reconciled:     reconciled,
+		APY:            apy,
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import "database/sql"
+
+// StakingEventRow represents a single row of the staking_event table, without its fields
+type StakingEventRow struct {
+	TransactionID string         `db:"transaction_id"`
+	EventIndex    int            `db:"event_index"`
+	Height        uint64         `db:"height"`
+	Type          string         `db:"type"`
+	NodeID        sql.NullString `db:"node_id"`
+	DelegatorID   sql.NullInt64  `db:"delegator_id"`
+	Amount        sql.NullInt64  `db:"amount"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v StakingEventRow) Equal(w StakingEventRow) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.EventIndex == w.EventIndex &&
+		v.Height == w.Height &&
+		v.Type == w.Type &&
+		v.NodeID == w.NodeID &&
+		v.DelegatorID == w.DelegatorID &&
+		v.Amount == w.Amount
+}
+
+// NewStakingEventRow allows to build a new StakingEventRow
+func NewStakingEventRow(
+	transactionID string,
+	eventIndex int,
+	height uint64,
+	eventType string,
+	nodeID sql.NullString,
+	delegatorID sql.NullInt64,
+	amount sql.NullInt64) StakingEventRow {
+	return StakingEventRow{
+		TransactionID: transactionID,
+		EventIndex:    eventIndex,
+		Height:        height,
+		Type:          eventType,
+		NodeID:        nodeID,
+		DelegatorID:   delegatorID,
+		Amount:        amount,
+	}
+}
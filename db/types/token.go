@@ -1,5 +1,7 @@
 package types
 
+import "database/sql"
+
 // SupplyRow represents a single row of the supply table
 type SupplyRow struct {
 	OneRowId bool   `db:"one_row_id"`
@@ -24,3 +26,98 @@ func NewSupplyRow(
 		Supply:   supply,
 	}
 }
+
+// SupplyHistoryRow represents a single row of the supply_history table
+type SupplyHistoryRow struct {
+	Height uint64 `db:"height"`
+	Supply uint64 `db:"supply"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v SupplyHistoryRow) Equal(w SupplyHistoryRow) bool {
+	return v.Height == w.Height &&
+		v.Supply == w.Supply
+}
+
+// NewSupplyHistoryRow allows to build a new SupplyHistoryRow
+func NewSupplyHistoryRow(
+	height uint64,
+	supply uint64) SupplyHistoryRow {
+	return SupplyHistoryRow{
+		Height: height,
+		Supply: supply,
+	}
+}
+
+// TokenTransferRow represents a single row of the token_transfer table
+type TokenTransferRow struct {
+	TransactionID string         `db:"transaction_id"`
+	Height        uint64         `db:"height"`
+	TransferIndex int            `db:"transfer_index"`
+	Token         string         `db:"token"`
+	From          sql.NullString `db:"from"`
+	To            sql.NullString `db:"to"`
+	Amount        uint64         `db:"amount"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TokenTransferRow) Equal(w TokenTransferRow) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.TransferIndex == w.TransferIndex &&
+		v.Token == w.Token &&
+		v.From == w.From &&
+		v.To == w.To &&
+		v.Amount == w.Amount
+}
+
+// NewTokenTransferRow allows to build a new TokenTransferRow.
+// Empty addresses are stored as NULL
+func NewTokenTransferRow(
+	transactionID string,
+	height uint64,
+	transferIndex int,
+	token string,
+	from string,
+	to string,
+	amount uint64) TokenTransferRow {
+	return TokenTransferRow{
+		TransactionID: transactionID,
+		Height:        height,
+		TransferIndex: transferIndex,
+		Token:         token,
+		From:          sql.NullString{String: from, Valid: from != ""},
+		To:            sql.NullString{String: to, Valid: to != ""},
+		Amount:        amount,
+	}
+}
+
+// AccountTokenBalanceRow represents a single row of the account_token_balance table
+type AccountTokenBalanceRow struct {
+	Address string `db:"address"`
+	Token   string `db:"token"`
+	Balance uint64 `db:"balance"`
+	Height  uint64 `db:"height"`
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountTokenBalanceRow) Equal(w AccountTokenBalanceRow) bool {
+	return v.Address == w.Address &&
+		v.Token == w.Token &&
+		v.Balance == w.Balance &&
+		v.Height == w.Height
+}
+
+// NewAccountTokenBalanceRow allows to build a new AccountTokenBalanceRow
+func NewAccountTokenBalanceRow(
+	address string,
+	token string,
+	balance uint64,
+	height uint64) AccountTokenBalanceRow {
+	return AccountTokenBalanceRow{
+		Address: address,
+		Token:   token,
+		Balance: balance,
+		Height:  height,
+	}
+}
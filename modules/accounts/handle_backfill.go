@@ -0,0 +1,73 @@
+package accounts
+
+import (
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	accountsutils "github.com/HarleyAppleChoi/junomum/modules/accounts/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// backfillBatchSize represents the number of blocks whose events are read at once during the backfill
+const backfillBatchSize = 1000
+
+// Backfill rebuilds the accounts creations using the flow.AccountCreated events already stored inside the database
+func Backfill(db *db.Db) error {
+	firstHeight, err := db.GetFirstBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	lastHeight, err := db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	for from := firstHeight; from <= lastHeight; from += backfillBatchSize {
+		to := from + backfillBatchSize - 1
+		if to > lastHeight {
+			to = lastHeight
+		}
+
+		log.Debug().Str("module", "accounts").Int64("from", from).Int64("to", to).Msg("backfilling creations")
+
+		events, err := db.GetEventsByType([]string{flow.EventAccountCreated}, from, to)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+
+		transactionIDs := make([]string, len(events))
+		for i, event := range events {
+			transactionIDs[i] = event.TransactionID
+		}
+
+		payers, err := db.GetTransactionPayers(transactionIDs)
+		if err != nil {
+			return err
+		}
+
+		var creations []types.AccountCreation
+		for _, event := range events {
+			creation, ok, err := accountsutils.ParseAccountCreatedEvent(event, payers[event.TransactionID])
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				creations = append(creations, creation)
+			}
+		}
+
+		err = db.SaveAccountCreations(creations)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
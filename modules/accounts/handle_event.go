@@ -0,0 +1,21 @@
+package accounts
+
+import (
+	"github.com/rs/zerolog/log"
+
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	accountsutils "github.com/HarleyAppleChoi/junomum/modules/accounts/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleEvent stores the creation of a new account, considering the payer of the transaction as its creator
+func HandleEvent(event types.Event, tx *types.Tx, db *db.Db) error {
+	creation, ok, err := accountsutils.ParseAccountCreatedEvent(event, tx.Payer)
+	if err != nil || !ok {
+		return err
+	}
+
+	log.Debug().Str("module", "accounts").Str("address", creation.Address).Msg("account created")
+
+	return db.SaveAccountCreations([]types.AccountCreation{creation})
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// ParseAccountCreatedEvent returns the account creation described by the given flow.AccountCreated event,
+// paid by the given payer. It returns false if the given event is not a flow.AccountCreated event
+func ParseAccountCreatedEvent(event types.Event, payer string) (types.AccountCreation, bool, error) {
+	if event.Type != flow.EventAccountCreated {
+		return types.AccountCreation{}, false, nil
+	}
+
+	value, ok := event.Field("address")
+	address, isAddress := value.(cadence.Address)
+	if !ok || !isAddress {
+		return types.AccountCreation{}, false, fmt.Errorf("invalid address of event %s: %s", event.Type, value)
+	}
+
+	return types.NewAccountCreation(address.Hex(), payer, event.TransactionID, uint64(event.Height)), true, nil
+}
@@ -0,0 +1,26 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/accounts/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+func accountEvent(eventType string) types.Event {
+	return testutil.NewEvent(10, eventType, 0, testutil.AddressField("address", []byte{0x02}))
+}
+
+func TestParseAccountCreatedEvent(t *testing.T) {
+	creation, ok, err := utils.ParseAccountCreatedEvent(accountEvent("flow.AccountCreated"), "0000000000000001")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, types.NewAccountCreation("0000000000000002", "0000000000000001", "0x6", 10), creation)
+
+	_, ok, err = utils.ParseAccountCreatedEvent(accountEvent("flow.AccountKeyAdded"), "0000000000000001")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,25 @@
+package actions
+
+import (
+	"github.com/rs/zerolog/log"
+
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleTx stores the action performed by the given transaction, if its script matches a known template
+func HandleTx(registry *Registry, db *db.Db, tx *types.Tx) error {
+	action, err := registry.Action(*tx)
+	if err != nil {
+		return err
+	}
+
+	if action == nil {
+		return nil
+	}
+
+	log.Debug().Str("module", "actions").Str("tx", tx.TransactionID).
+		Str("action", action.Action).Msg("recognised transaction template")
+
+	return db.SaveTransactionActions([]types.TransactionAction{*action})
+}
@@ -0,0 +1,55 @@
+package actions
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+)
+
+// Module represents the module that recognises the transactions built from known templates
+type Module struct {
+	messagesParser messages.MessageAddressesParser
+	encodingConfig *params.EncodingConfig
+	flowClient     client.Proxy
+	db             *db.Db
+	registry       *Registry
+}
+
+// NewModule builds a new Module instance
+func NewModule(
+	messagesParser messages.MessageAddressesParser,
+	flowClient client.Proxy,
+	encodingConfig *params.EncodingConfig, db *db.Db,
+) *Module {
+	registry, err := NewRegistry(flowClient.Contract(), DefaultTemplates()...)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		messagesParser: messagesParser,
+		encodingConfig: encodingConfig,
+		flowClient:     flowClient,
+		db:             db,
+		registry:       registry,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "actions"
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(index int, tx *types.Tx) error {
+	return HandleTx(m.registry, m.db, tx)
+}
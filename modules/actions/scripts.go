@@ -0,0 +1,247 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+const transferFlowScript = `
+import FungibleToken from 0xFUNGIBLETOKENADDRESS
+import FlowToken from 0xFLOWTOKENADDRESS
+
+transaction(amount: UFix64, to: Address) {
+
+    // The Vault resource that holds the tokens that are being transferred
+    let sentVault: @FungibleToken.Vault
+
+    prepare(signer: AuthAccount) {
+
+        // Get a reference to the signer's stored vault
+        let vaultRef = signer.borrow<&FlowToken.Vault>(from: /storage/flowTokenVault)
+			?? panic("Could not borrow reference to the owner's Vault!")
+
+        // Withdraw tokens from the signer's stored vault
+        self.sentVault <- vaultRef.withdraw(amount: amount)
+    }
+
+    execute {
+
+        // Get the recipient's public account object
+        let recipient = getAccount(to)
+
+        // Get a reference to the recipient's Receiver
+        let receiverRef = recipient.getCapability(/public/flowTokenReceiver)
+            .borrow<&{FungibleToken.Receiver}>()
+			?? panic("Could not borrow receiver reference to the recipient's Vault")
+
+        // Deposit the withdrawn tokens in the recipient's receiver
+        receiverRef.deposit(from: <-self.sentVault)
+    }
+}
+`
+
+// stakingCollectionScript is the template shared by all the staking collection transactions
+// that operate on the tokens of a node or delegator
+const stakingCollectionScript = `
+import FlowStakingCollection from 0xSTAKINGCOLLECTIONADDRESS
+
+transaction(nodeID: String, delegatorID: UInt32?, amount: UFix64) {
+
+    let stakingCollectionRef: &FlowStakingCollection.StakingCollection
+
+    prepare(account: AuthAccount) {
+        self.stakingCollectionRef = account.borrow<&FlowStakingCollection.StakingCollection>(from: FlowStakingCollection.StakingCollectionStoragePath)
+            ?? panic("Could not borrow ref to StakingCollection")
+    }
+
+    execute {
+        self.stakingCollectionRef.%s(nodeID: nodeID, delegatorID: delegatorID, amount: amount)
+    }
+}
+`
+
+const stakingCollectionRegisterDelegatorScript = `
+import FlowStakingCollection from 0xSTAKINGCOLLECTIONADDRESS
+
+transaction(id: String, amount: UFix64) {
+
+    let stakingCollectionRef: &FlowStakingCollection.StakingCollection
+
+    prepare(account: AuthAccount) {
+        self.stakingCollectionRef = account.borrow<&FlowStakingCollection.StakingCollection>(from: FlowStakingCollection.StakingCollectionStoragePath)
+            ?? panic("Could not borrow ref to StakingCollection")
+    }
+
+    execute {
+        self.stakingCollectionRef.registerDelegator(nodeID: id, amount: amount)
+    }
+}
+`
+
+// lockedDelegatorScript is the template shared by all the locked tokens transactions
+// that operate on the tokens of the delegator owned by the signer
+const lockedDelegatorScript = `
+import LockedTokens from 0xLOCKEDTOKENADDRESS
+
+transaction(amount: UFix64) {
+
+    let nodeDelegatorProxy: LockedTokens.LockedNodeDelegatorProxy
+
+    prepare(account: AuthAccount) {
+        let tokenHolder = account.borrow<&LockedTokens.TokenHolder>(from: LockedTokens.TokenHolderStoragePath)
+            ?? panic("TokenHolder is not saved at specified path")
+
+        self.nodeDelegatorProxy = tokenHolder.borrowDelegator()
+    }
+
+    execute {
+        self.nodeDelegatorProxy.%s(amount: amount)
+    }
+}
+`
+
+const lockedRegisterDelegatorScript = `
+import LockedTokens from 0xLOCKEDTOKENADDRESS
+
+transaction(id: String, amount: UFix64) {
+
+    let holderRef: &LockedTokens.TokenHolder
+
+    prepare(account: AuthAccount) {
+        self.holderRef = account.borrow<&LockedTokens.TokenHolder>(from: LockedTokens.TokenHolderStoragePath)
+            ?? panic("TokenHolder is not saved at specified path")
+    }
+
+    execute {
+        self.holderRef.createNodeDelegator(nodeID: id)
+
+        let delegatorProxy = self.holderRef.borrowDelegator()
+
+        delegatorProxy.delegateNewTokens(amount: amount)
+    }
+}
+`
+
+// DefaultTemplates returns the templates of the most common transactions
+func DefaultTemplates() []Template {
+	return []Template{
+		NewTemplate("transfer_flow", transferFlowScript, parseTransfer),
+		NewTemplate("create_account", string(templates.CreateAccount(nil, nil, flow.EmptyAddress).Script), parseCreateAccount),
+
+		NewTemplate("stake_new_tokens", fmt.Sprintf(stakingCollectionScript, "stakeNewTokens"), parseStakingCollection),
+		NewTemplate("stake_unstaked_tokens", fmt.Sprintf(stakingCollectionScript, "stakeUnstakedTokens"), parseStakingCollection),
+		NewTemplate("stake_rewarded_tokens", fmt.Sprintf(stakingCollectionScript, "stakeRewardedTokens"), parseStakingCollection),
+		NewTemplate("request_unstaking", fmt.Sprintf(stakingCollectionScript, "requestUnstaking"), parseStakingCollection),
+		NewTemplate("withdraw_unstaked_tokens", fmt.Sprintf(stakingCollectionScript, "withdrawUnstakedTokens"), parseStakingCollection),
+		NewTemplate("withdraw_rewarded_tokens", fmt.Sprintf(stakingCollectionScript, "withdrawRewardedTokens"), parseStakingCollection),
+		NewTemplate("register_delegator", stakingCollectionRegisterDelegatorScript, parseRegisterDelegator),
+
+		NewTemplate("locked_delegate_new_tokens", fmt.Sprintf(lockedDelegatorScript, "delegateNewTokens"), parseLockedDelegator),
+		NewTemplate("locked_delegate_unstaked_tokens", fmt.Sprintf(lockedDelegatorScript, "delegateUnstakedTokens"), parseLockedDelegator),
+		NewTemplate("locked_delegate_rewarded_tokens", fmt.Sprintf(lockedDelegatorScript, "delegateRewardedTokens"), parseLockedDelegator),
+		NewTemplate("locked_request_unstaking", fmt.Sprintf(lockedDelegatorScript, "requestUnstaking"), parseLockedDelegator),
+		NewTemplate("locked_withdraw_unstaked_tokens", fmt.Sprintf(lockedDelegatorScript, "withdrawUnstakedTokens"), parseLockedDelegator),
+		NewTemplate("locked_withdraw_rewarded_tokens", fmt.Sprintf(lockedDelegatorScript, "withdrawRewardedTokens"), parseLockedDelegator),
+		NewTemplate("locked_register_delegator", lockedRegisterDelegatorScript, parseRegisterDelegator),
+	}
+}
+
+// signer returns the first authorizer of the given transaction, which is the account
+// whose tokens are moved by all the default templates
+func signer(tx types.Tx) string {
+	if len(tx.Authorizers) == 0 {
+		return ""
+	}
+	return tx.Authorizers[0]
+}
+
+// checkArguments returns an error if the given arguments are not the expected number
+func checkArguments(arguments []cadence.Value, expected int) error {
+	if len(arguments) != expected {
+		return fmt.Errorf("expected %d arguments, got %d", expected, len(arguments))
+	}
+	return nil
+}
+
+// parseTransfer parses the arguments of (amount: UFix64, to: Address)
+func parseTransfer(tx types.Tx, arguments []cadence.Value) (types.TransactionAction, error) {
+	if err := checkArguments(arguments, 2); err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	amount, err := utils.CadenceConvertUint64(arguments[0])
+	if err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	to, ok := arguments[1].(cadence.Address)
+	if !ok {
+		return types.TransactionAction{}, fmt.Errorf("cadence value is not an address: %s", arguments[1])
+	}
+
+	return types.TransactionAction{From: signer(tx), To: to.Hex(), Amount: amount}, nil
+}
+
+// parseCreateAccount parses the arguments of (publicKeys: [String], contracts: {String: String}).
+// The address of the new account is only known from the AccountCreated event
+func parseCreateAccount(tx types.Tx, _ []cadence.Value) (types.TransactionAction, error) {
+	return types.TransactionAction{From: signer(tx)}, nil
+}
+
+// parseStakingCollection parses the arguments of (nodeID: String, delegatorID: UInt32?, amount: UFix64)
+func parseStakingCollection(tx types.Tx, arguments []cadence.Value) (types.TransactionAction, error) {
+	if err := checkArguments(arguments, 3); err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	nodeID, err := utils.CadanceConvertString(arguments[0])
+	if err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	amount, err := utils.CadenceConvertUint64(arguments[2])
+	if err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	return types.TransactionAction{From: signer(tx), Amount: amount, NodeID: nodeID}, nil
+}
+
+// parseRegisterDelegator parses the arguments of (id: String, amount: UFix64)
+func parseRegisterDelegator(tx types.Tx, arguments []cadence.Value) (types.TransactionAction, error) {
+	if err := checkArguments(arguments, 2); err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	nodeID, err := utils.CadanceConvertString(arguments[0])
+	if err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	amount, err := utils.CadenceConvertUint64(arguments[1])
+	if err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	return types.TransactionAction{From: signer(tx), Amount: amount, NodeID: nodeID}, nil
+}
+
+// parseLockedDelegator parses the arguments of (amount: UFix64)
+func parseLockedDelegator(tx types.Tx, arguments []cadence.Value) (types.TransactionAction, error) {
+	if err := checkArguments(arguments, 1); err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	amount, err := utils.CadenceConvertUint64(arguments[0])
+	if err != nil {
+		return types.TransactionAction{}, err
+	}
+
+	return types.TransactionAction{From: signer(tx), Amount: amount}, nil
+}
@@ -0,0 +1,149 @@
+package actions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/onflow/cadence"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// ArgumentsParser builds the action performed by the given transaction from its decoded arguments
+type ArgumentsParser func(tx types.Tx, arguments []cadence.Value) (types.TransactionAction, error)
+
+// Template represents a known transaction script along with the parser of its arguments.
+// Contract addresses inside the script are written using the same placeholders used by
+// the flow-core-contracts transaction templates (eg. 0xFUNGIBLETOKENADDRESS)
+type Template struct {
+	Action string
+	Script string
+	Parse  ArgumentsParser
+}
+
+// NewTemplate allows to build a new Template instance
+func NewTemplate(action string, script string, parse ArgumentsParser) Template {
+	return Template{
+		Action: action,
+		Script: script,
+		Parse:  parse,
+	}
+}
+
+// Registry contains all the known templates, indexed by the hash of their normalised script
+type Registry struct {
+	templates map[string]Template
+
+	// matches caches the template matched by each raw script hash, so that scripts
+	// that are used over and over are normalised only once
+	mu      sync.RWMutex
+	matches map[string]*Template
+}
+
+// NewRegistry builds a new Registry containing the given templates, rendered using the given contracts addresses
+func NewRegistry(contracts client.Contracts, templates ...Template) (*Registry, error) {
+	registry := &Registry{
+		templates: make(map[string]Template, len(templates)),
+		matches:   make(map[string]*Template),
+	}
+
+	for _, template := range templates {
+		hash := NormalisedScriptHash([]byte(RenderScript(template.Script, contracts)))
+		if other, found := registry.templates[hash]; found {
+			return nil, fmt.Errorf("templates %s and %s have the same script", other.Action, template.Action)
+		}
+		registry.templates[hash] = template
+	}
+
+	return registry, nil
+}
+
+// Match returns the template matching the script of the given transaction, if any
+func (r *Registry) Match(tx types.Tx) (*Template, bool) {
+	scriptHash := tx.ScriptHash()
+
+	r.mu.RLock()
+	template, found := r.matches[scriptHash]
+	r.mu.RUnlock()
+	if found {
+		return template, template != nil
+	}
+
+	if known, ok := r.templates[NormalisedScriptHash(tx.Script)]; ok {
+		template = &known
+	}
+
+	r.mu.Lock()
+	r.matches[scriptHash] = template
+	r.mu.Unlock()
+
+	return template, template != nil
+}
+
+// Action returns the action performed by the given transaction, or nil if the
+// transaction does not match any known template
+func (r *Registry) Action(tx types.Tx) (*types.TransactionAction, error) {
+	template, found := r.Match(tx)
+	if !found {
+		return nil, nil
+	}
+
+	arguments, err := tx.DecodedArguments()
+	if err != nil {
+		// The action cannot be told without its arguments, so the transaction is left untyped
+		log.Debug().Str("module", "actions").Str("tx", tx.TransactionID).Err(err).Msg("cannot decode arguments")
+		return nil, nil
+	}
+
+	action, err := template.Parse(tx, arguments)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing %s arguments of tx %s: %s", template.Action, tx.TransactionID, err)
+	}
+
+	action.TransactionID = tx.TransactionID
+	action.Height = tx.Height
+	action.Action = template.Action
+	return &action, nil
+}
+
+// RenderScript replaces the contract placeholders of the given template script with the given contracts addresses
+func RenderScript(script string, contracts client.Contracts) string {
+	return strings.NewReplacer(
+		"0xFUNGIBLETOKENADDRESS", contracts.FungibleToken,
+		"0xFLOWTOKENADDRESS", contracts.FlowToken,
+		"0xFLOWFEESADDRESS", contracts.FlowFee,
+		"0xIDENTITYTABLEADDRESS", contracts.StakingTable,
+		"0xLOCKEDTOKENADDRESS", contracts.LockedTokens,
+		"0xSTAKINGPROXYADDRESS", contracts.StakingProxy,
+		"0xNONFUNGIBLETOKENADDRESS", contracts.NonFungibleToken,
+		"0xSTAKINGCOLLECTIONADDRESS", contracts.StakingCollection,
+	).Replace(script)
+}
+
+var (
+	lineCommentRegExp  = regexp.MustCompile(`//[^\n]*`)
+	blockCommentRegExp = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	whitespaceRegExp   = regexp.MustCompile(`\s+`)
+)
+
+// NormaliseScript removes all the comments from the given script and collapses each run of whitespaces
+// into a single space, so that scripts differing only in their comments, indentation or line breaks
+// are considered the same. Identifiers and token spacing are preserved
+func NormaliseScript(script []byte) string {
+	normalised := blockCommentRegExp.ReplaceAllString(string(script), "")
+	normalised = lineCommentRegExp.ReplaceAllString(normalised, "")
+	normalised = whitespaceRegExp.ReplaceAllString(normalised, " ")
+	return strings.TrimSpace(normalised)
+}
+
+// NormalisedScriptHash returns the hex encoded sha256 hash of the normalised script
+func NormalisedScriptHash(script []byte) string {
+	hash := sha256.Sum256([]byte(NormaliseScript(script)))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,166 @@
+package actions_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/actions"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func TestNormaliseScript(t *testing.T) {
+	first := []byte(`
+// Transfers tokens
+transaction(amount: UFix64) {
+    /* nothing to prepare */
+    prepare(signer: AuthAccount) {}
+}`)
+	second := []byte("transaction(amount: UFix64) {\n\tprepare(signer: AuthAccount) {}\n}\n")
+	require.Equal(t, actions.NormalisedScriptHash(first), actions.NormalisedScriptHash(second))
+
+	// Scripts differing in their identifiers casing or token spacing are different scripts
+	require.NotEqual(t, actions.NormalisedScriptHash(first),
+		actions.NormalisedScriptHash([]byte(`transaction(amount: UFix64) { prepare(signer: authAccount) {} }`)))
+	require.NotEqual(t, actions.NormalisedScriptHash(first),
+		actions.NormalisedScriptHash([]byte(`transaction(amount:UFix64){prepare(signer:AuthAccount){}}`)))
+}
+
+func TestRegistry_Action(t *testing.T) {
+	contracts := client.MainnetContracts()
+	registry, err := actions.NewRegistry(contracts, actions.DefaultTemplates()...)
+	require.NoError(t, err)
+
+	// Use a different formatting from the one of the template
+	var script string
+	for _, template := range actions.DefaultTemplates() {
+		if template.Action == "transfer_flow" {
+			script = strings.ReplaceAll(actions.RenderScript(template.Script, contracts), "    ", "\t")
+		}
+	}
+
+	tx := types.NewTx(10, "0x6", []byte(script), [][]byte{
+		[]byte(`{"type":"UFix64","value":"1.50000000"}`),
+		[]byte(`{"type":"Address","value":"0x1654653399040a61"}`),
+	}, "0x2", 100, "8d0e87b65159ae63", "8d0e87b65159ae63", []string{"8d0e87b65159ae63"}, nil, nil)
+
+	action, err := registry.Action(tx)
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	require.True(t, action.Equal(types.NewTransactionAction(
+		"0x6", 10, "transfer_flow", "8d0e87b65159ae63", "1654653399040a61", 150000000, "",
+	)))
+
+	// Arguments that cannot be decoded should leave the transaction untyped
+	undecodable := tx
+	undecodable.Arguments = [][]byte{[]byte(`not json-cdc`), tx.Arguments[1]}
+	action, err = registry.Action(undecodable)
+	require.NoError(t, err)
+	require.Nil(t, action)
+
+	// Templates rendered with other contracts addresses should not match
+	tx.Script = []byte(strings.ReplaceAll(script, contracts.FlowToken, client.TestnetContracts().FlowToken))
+	action, err = registry.Action(tx)
+	require.NoError(t, err)
+	require.Nil(t, action)
+}
+
+func TestRegistry_StakingCollection(t *testing.T) {
+	contracts := client.TestnetContracts()
+	registry, err := actions.NewRegistry(contracts, actions.DefaultTemplates()...)
+	require.NoError(t, err)
+
+	for _, template := range actions.DefaultTemplates() {
+		if template.Action != "stake_new_tokens" {
+			continue
+		}
+
+		tx := types.NewTx(10, "0x7", []byte(actions.RenderScript(template.Script, contracts)), [][]byte{
+			[]byte(`{"type":"String","value":"node"}`),
+			[]byte(`{"type":"Optional","value":null}`),
+			[]byte(`{"type":"UFix64","value":"100.00000000"}`),
+		}, "0x2", 100, "95e019a17d0e23d7", "95e019a17d0e23d7", []string{"95e019a17d0e23d7"}, nil, nil)
+
+		action, err := registry.Action(tx)
+		require.NoError(t, err)
+		require.NotNil(t, action)
+		require.True(t, action.Equal(types.NewTransactionAction(
+			"0x7", 10, "stake_new_tokens", "95e019a17d0e23d7", "", 10000000000, "node",
+		)))
+	}
+}
+
+// upstreamTemplates maps the actions of the default templates to the path of the
+// flow-core-contracts transaction that wallets send to perform them
+var upstreamTemplates = map[string]string{
+	"transfer_flow": "transactions/flowToken/transfer_tokens.cdc",
+
+	"stake_new_tokens":         "transactions/stakingCollection/stake_new_tokens.cdc",
+	"stake_unstaked_tokens":    "transactions/stakingCollection/stake_unstaked_tokens.cdc",
+	"stake_rewarded_tokens":    "transactions/stakingCollection/stake_rewarded_tokens.cdc",
+	"request_unstaking":        "transactions/stakingCollection/request_unstaking.cdc",
+	"withdraw_unstaked_tokens": "transactions/stakingCollection/withdraw_unstaked_tokens.cdc",
+	"withdraw_rewarded_tokens": "transactions/stakingCollection/withdraw_rewarded_tokens.cdc",
+	"register_delegator":       "transactions/stakingCollection/register_delegator.cdc",
+
+	"locked_delegate_new_tokens":      "transactions/lockedTokens/delegator/delegate_new_tokens.cdc",
+	"locked_delegate_unstaked_tokens": "transactions/lockedTokens/delegator/delegate_unstaked_tokens.cdc",
+	"locked_delegate_rewarded_tokens": "transactions/lockedTokens/delegator/delegate_rewarded_tokens.cdc",
+	"locked_request_unstaking":        "transactions/lockedTokens/delegator/request_unstaking.cdc",
+	"locked_withdraw_unstaked_tokens": "transactions/lockedTokens/delegator/withdraw_unstaked_tokens.cdc",
+	"locked_withdraw_rewarded_tokens": "transactions/lockedTokens/delegator/withdraw_rewarded_tokens.cdc",
+	"locked_register_delegator":       "transactions/lockedTokens/delegator/register_delegator.cdc",
+}
+
+// upstreamRef is the flow-core-contracts revision the templates are pinned against
+const upstreamRef = "master"
+
+// readUpstream returns the content of the given flow-core-contracts file, reading it from the checkout
+// FLOW_CORE_CONTRACTS_DIR points to if set, or downloading it from GitHub otherwise
+func readUpstream(t *testing.T, path string) []byte {
+	if dir := os.Getenv("FLOW_CORE_CONTRACTS_DIR"); dir != "" {
+		content, err := ioutil.ReadFile(filepath.Join(dir, path))
+		require.NoError(t, err)
+		return content
+	}
+
+	client := http.Client{Timeout: 30 * time.Second}
+	res, err := client.Get(fmt.Sprintf("https://raw.githubusercontent.com/onflow/flow-core-contracts/%s/%s", upstreamRef, path))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "cannot download %s", path)
+
+	content, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	return content
+}
+
+// TestDefaultTemplates_Upstream pins the default templates against the flow-core-contracts transactions,
+// so that any drift from the scripts actually sent by wallets is caught rather than silently not matched
+func TestDefaultTemplates_Upstream(t *testing.T) {
+	contracts := client.MainnetContracts()
+	for _, template := range actions.DefaultTemplates() {
+		if template.Action == "create_account" {
+			// Taken from the flow-go-sdk templates package
+			continue
+		}
+
+		path, found := upstreamTemplates[template.Action]
+		require.True(t, found, "missing upstream transaction of %s", template.Action)
+
+		upstream := readUpstream(t, path)
+		require.Equal(t,
+			actions.NormaliseScript([]byte(actions.RenderScript(string(upstream), contracts))),
+			actions.NormaliseScript([]byte(actions.RenderScript(template.Script, contracts))),
+			"template %s differs from %s", template.Action, path,
+		)
+	}
+}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	authutils "github.com/HarleyAppleChoi/junomum/modules/auth/utils"
+)
+
+// HandleBlock gathers the accounts involved in all the transactions of the block,
+// and refreshes each of them once at the block height
+func HandleBlock(getAddresses messages.MessageAddressesParser, cdc codec.Marshaler, db *db.Db, flowClient client.Proxy, block *flow.Block, txs types.Txs) error {
+	var addresses []string
+	for _, tx := range txs {
+		arguments, err := tx.DecodedArguments()
+		if err != nil {
+			// Fall back to the addresses that can be found without the arguments
+			log.Debug().Str("module", "auth").Str("tx", tx.TransactionID).Err(err).Msg("cannot decode arguments")
+			arguments = nil
+		}
+
+		txAddresses, err := getAddresses(cdc, tx, tx.Events, arguments)
+		if err != nil {
+			return err
+		}
+
+		addresses = append(addresses, txAddresses...)
+	}
+
+	return authutils.UpdateAccounts(addresses, db, int64(block.Height), flowClient)
+}
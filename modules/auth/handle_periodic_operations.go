@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	authutils "github.com/HarleyAppleChoi/junomum/modules/auth/utils"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// refreshing tells whether a refresh of all the accounts is running, so that refreshes never overlap
+var refreshing int32
+
+// RegisterPeriodicOps registers the utils that should be run periodically
+func RegisterPeriodicOps(scheduler *gocron.Scheduler, cfg *config.AuthConfig, db *database.Db, flowClient client.Proxy) error {
+	log.Debug().Str("module", "auth").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(cfg.GetRefreshInterval()).Minutes().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return refreshAllAccounts(cfg, db, flowClient) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// refreshAllAccounts refreshes all the stored accounts at the last parsed height, most recently active first,
+// so that their data is never more recent than the parsed blocks.
+// Accounts are refreshed in chunks, and the last refreshed account is stored after each chunk
+// so that an interrupted refresh is resumed rather than restarted. Failing accounts are logged and skipped
+func refreshAllAccounts(cfg *config.AuthConfig, db *database.Db, flowClient client.Proxy) error {
+	if !atomic.CompareAndSwapInt32(&refreshing, 0, 1) {
+		log.Debug().Str("module", "auth").Msg("accounts refresh already running")
+		return nil
+	}
+	defer atomic.StoreInt32(&refreshing, 0)
+
+	height, err := db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	cursor, err := db.GetAccountRefreshCursor()
+	if err != nil {
+		return err
+	}
+
+	for {
+		accounts, err := db.GetAccountsToRefresh(cursor, cfg.GetRefreshChunkSize())
+		if err != nil {
+			return err
+		}
+
+		if len(accounts) == 0 {
+			log.Debug().Str("module", "auth").Int64("height", height).Msg("refreshed all the accounts")
+			return db.DeleteAccountRefreshCursor()
+		}
+
+		addresses := make([]string, len(accounts))
+		for i, account := range accounts {
+			addresses[i] = account.Address
+		}
+
+		// Accounts that cannot be refreshed are skipped, so that they do not block the rest of the refresh
+		err = authutils.UpdateAccounts(addresses, db, height, flowClient)
+		if err != nil {
+			log.Error().Str("module", "auth").Int64("height", height).Strs("addresses", addresses).
+				Err(err).Msg("error while refreshing accounts, skipping")
+		}
+
+		cursor = &accounts[len(accounts)-1]
+		err = db.SaveAccountRefreshCursor(*cursor)
+		if err != nil {
+			return err
+		}
+
+		time.Sleep(cfg.GetRefreshChunkDelay())
+	}
+}
@@ -5,19 +5,23 @@ import (
 	"github.com/HarleyAppleChoi/junomum/modules/messages"
 	"github.com/HarleyAppleChoi/junomum/modules/modules"
 	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
 
 	"github.com/HarleyAppleChoi/junomum/client"
 	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
 	"github.com/go-co-op/gocron"
+	"github.com/onflow/flow-go-sdk"
 )
 
 var (
-	_ modules.Module            = &Module{}
-	_ modules.TransactionModule = &Module{}
+	_ modules.Module                   = &Module{}
+	_ modules.ParsedBlockModule        = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
 )
 
 // Module represents the x/auth module
 type Module struct {
+	cfg            *config.AuthConfig
 	messagesParser messages.MessageAddressesParser
 	encodingConfig *params.EncodingConfig
 	flowClient     client.Proxy
@@ -26,11 +30,13 @@ type Module struct {
 
 // NewModule builds a new Module instance
 func NewModule(
+	cfg *config.AuthConfig,
 	messagesParser messages.MessageAddressesParser,
 	flowClient client.Proxy,
 	encodingConfig *params.EncodingConfig, db *db.Db,
 ) *Module {
 	return &Module{
+		cfg:            cfg,
 		messagesParser: messagesParser,
 		encodingConfig: encodingConfig,
 		flowClient:     flowClient,
@@ -43,12 +49,12 @@ func (m *Module) Name() string {
 	return "auth"
 }
 
-// HandleEvent implements modules.MessageModule
-func (m *Module) HandleTx(index int, tx *types.Tx) error {
-	return HandleTxs(m.messagesParser, m.encodingConfig.Marshaler, m.db, m.flowClient, tx)
+// HandleParsedBlock implements modules.ParsedBlockModule
+func (m *Module) HandleParsedBlock(block *flow.Block, txs types.Txs) error {
+	return HandleBlock(m.messagesParser, m.encodingConfig.Marshaler, m.db, m.flowClient, block, txs)
 }
 
 // RegisterPeriodicOperations implements modules.Module
 func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
-	return nil //RegisterPeriodicOps(scheduler, m.db, m.flowClient)
+	return RegisterPeriodicOps(scheduler, m.cfg, m.db, m.flowClient)
 }
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/HarleyAppleChoi/junomum/client"
 	"github.com/HarleyAppleChoi/junomum/types"
@@ -12,8 +13,9 @@ import (
 	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
 )
 
-// GetAccounts returns the account data for the given addresses
-func GetAccounts(addresses []string, height int64, client client.Proxy) ([]types.Account, error) {
+// GetAccounts returns the account data for the given addresses.
+// Addresses that do not exist at the given height are skipped
+func GetAccounts(addresses []string, height int64, flowClient client.Proxy) ([]types.Account, error) {
 	log.Debug().Str("module", "auth").Str("operation", "accounts").Int("height", int(height)).Msg("getting accounts data")
 	var accounts []types.Account
 
@@ -21,17 +23,18 @@ func GetAccounts(addresses []string, height int64, client client.Proxy) ([]types
 		if address == "" {
 			continue
 		}
-		//not working atm because of flow bug
-		//account,err:=client.Client().GetAccountAtBlockHeight(client.Ctx(),flow.HexToAddress(address),uint64(height))
-
-		account, err := client.Client().GetAccount(client.Ctx(), flow.HexToAddress(address))
+		account, err := flowClient.Client().GetAccountAtBlockHeight(flowClient.Ctx(), flow.HexToAddress(address), uint64(height))
+		if client.IsNotFoundError(err) {
+			log.Debug().Str("module", "auth").Str("address", address).Int64("height", height).Msg("account not found, skipping")
+			continue
+		}
 
 		if err != nil {
 			return nil, err
 		}
 
 		if account == nil {
-			return nil, fmt.Errorf("address is not valid and cannot get details")
+			return nil, fmt.Errorf("address %s is not valid and cannot get details", address)
 		}
 
 		newAccount, err := types.NewAccount(*account)
@@ -46,81 +49,177 @@ func GetAccounts(addresses []string, height int64, client client.Proxy) ([]types
 	return accounts, nil
 }
 
+// maxParallelRefreshes is the max number of accounts refreshed at the same time across all the callers,
+// so that the access node is not flooded with scripts
+const maxParallelRefreshes = 10
+
+var refreshWorkers = make(chan struct{}, maxParallelRefreshes)
+
+// accountData contains all the data refreshed for a single account
+type accountData struct {
+	accounts       []types.Account
+	storages       []types.AccountStorage
+	lockedAccounts []types.LockedAccount
+	lockedBalances []types.LockedAccountBalance
+	delegators     []types.DelegatorAccount
+	stakers        []types.StakerNodeId
+}
+
+// accountDataGetter returns all the data of the given address at the given height
+type accountDataGetter func(address string, height int64) (accountData, error)
+
 // UpdateAccounts takes the given addresses and for each one queries the chain
-// retrieving the account data and stores it inside the database.
+// retrieving the account data at the given height and stores it inside the database.
+// Each address is refreshed only once, even if it is given multiple times.
+// Addresses whose data cannot be read are logged and skipped, so that they do not prevent
+// the others from being stored
 func UpdateAccounts(addresses []string, db *db.Db, height int64, client client.Proxy) error {
-	accounts, err := GetAccounts(addresses, height, client)
-	if err != nil {
-		return err
+	data := fetchAccountsData(addresses, height, func(address string, height int64) (accountData, error) {
+		return getAccountData(address, height, client)
+	})
+
+	return saveAccountData(data, height, db)
+}
+
+// fetchAccountsData reads the data of each of the given addresses at the given height using getData,
+// calling it only once per address, and merges the results. Addresses that cannot be read are logged and skipped
+func fetchAccountsData(addresses []string, height int64, getData accountDataGetter) accountData {
+	addresses = UniqueAddresses(addresses)
+	if len(addresses) == 0 {
+		return accountData{}
 	}
 
-	err = db.SaveAccounts(accounts, uint64(height))
-	if err != nil {
-		return err
+	log.Debug().Str("module", "auth").Int64("height", height).Int("accounts", len(addresses)).Msg("refreshing accounts")
+
+	data := make([]accountData, len(addresses))
+	errs := make([]error, len(addresses))
+
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+
+			refreshWorkers <- struct{}{}
+			defer func() { <-refreshWorkers }()
+
+			data[i], errs[i] = getData(address, height)
+		}(i, address)
 	}
+	wg.Wait()
+
+	var all accountData
+	for i, address := range addresses {
+		if errs[i] != nil {
+			log.Error().Str("module", "auth").Str("address", address).Int64("height", height).
+				Err(errs[i]).Msg("error while refreshing account, skipping")
+			continue
+		}
+
+		all.accounts = append(all.accounts, data[i].accounts...)
+		all.storages = append(all.storages, data[i].storages...)
+		all.lockedAccounts = append(all.lockedAccounts, data[i].lockedAccounts...)
+		all.lockedBalances = append(all.lockedBalances, data[i].lockedBalances...)
+		all.delegators = append(all.delegators, data[i].delegators...)
+		all.stakers = append(all.stakers, data[i].stakers...)
+	}
+
+	return all
+}
+
+// getAccountData queries the chain retrieving all the data of the given address at the given height
+func getAccountData(address string, height int64, client client.Proxy) (accountData, error) {
+	var data accountData
+	var err error
 
-	err = UpdateLockedAccount(addresses, height, client, db)
+	addresses := []string{address}
+
+	data.accounts, err = GetAccounts(addresses, height, client)
 	if err != nil {
-		return err
+		return accountData{}, err
+	}
+
+	// The account does not exist at this height, so there is nothing else to read
+	if len(data.accounts) == 0 {
+		return accountData{}, nil
 	}
 
-	stakerAccount, err := GetStakerAccounts(addresses, height, client)
+	data.storages, err = GetAccountStorage(addresses, height, client)
 	if err != nil {
-		return err
+		return accountData{}, err
 	}
 
-	if len(stakerAccount) != 0 {
-		err = db.SaveStakerNodeId(stakerAccount)
+	data.lockedAccounts, err = GetLockedAccount(addresses, height, client)
+	if err != nil {
+		return accountData{}, err
 	}
 
-	return err
-}
+	if len(data.lockedAccounts) != 0 {
+		data.lockedBalances, err = GetLockedAccountBalance(addresses, height, client)
+		if err != nil {
+			return accountData{}, err
+		}
+	}
 
-// UpdateLockedAccount get all the details that need for locked account
-func UpdateLockedAccount(addresses []string, height int64, client client.Proxy, db *db.Db) error {
-	lockedAccount, err := GetLockedAccount(addresses, height, client)
+	delegators, err := getDelegatorNodeInfo(address, height, client)
 	if err != nil {
-		return err
+		return accountData{}, fmt.Errorf("cannot get delegators of address %s: %s", address, err)
 	}
 
-	if len(lockedAccount) == 0 {
-		return nil
+	for _, delegator := range delegators {
+		data.delegators = append(data.delegators, types.NewDelegatorAccount(address, int64(delegator.Id), delegator.NodeID))
 	}
 
-	err = db.SaveLockedAccount(lockedAccount)
+	data.stakers, err = GetStakerAccounts(addresses, height, client)
 	if err != nil {
-		return err
+		return accountData{}, err
 	}
 
-	LockedAccountBalance, err := GetLockedAccountBalance(addresses, height, client)
+	return data, nil
+}
+
+// saveAccountData stores the given account data inside the database
+func saveAccountData(data accountData, height int64, db *db.Db) error {
+	err := db.SaveAccounts(data.accounts, uint64(height))
 	if err != nil {
 		return err
 	}
 
-	err = db.SaveLockedAccountBalance(LockedAccountBalance)
+	err = db.SaveAccountStorage(data.storages)
 	if err != nil {
 		return err
 	}
 
-	var delegatorsAccounts []types.DelegatorAccount
-	for _, address := range addresses {
-		accountdelegators, err := getDelegatorNodeInfo(address, height, client)
+	if len(data.lockedAccounts) != 0 {
+		err = db.SaveLockedAccount(data.lockedAccounts)
 		if err != nil {
-			return fmt.Errorf("cannot get delegators from address: %s", err)
-		}
-		if accountdelegators == nil {
-			continue
+			return err
 		}
+	}
 
-		for _, delegator := range accountdelegators {
-			delegatorsAccounts = append(delegatorsAccounts, types.NewDelegatorAccount(address, int64(delegator.Id), delegator.NodeID))
-		}
+	err = db.SaveLockedAccountBalance(data.lockedBalances)
+	if err != nil {
+		return err
 	}
 
-	err = db.SaveDelegatorAccounts(delegatorsAccounts)
+	err = db.SaveDelegatorAccounts(data.delegators)
 	if err != nil {
 		return fmt.Errorf("cannot save delegators from address: %s", err)
 	}
 
-	return nil
+	return db.SaveStakerNodeId(data.stakers)
+}
+
+// UniqueAddresses returns the given addresses without duplicates and empty values, preserving their order
+func UniqueAddresses(addresses []string) []string {
+	seen := make(map[string]bool, len(addresses))
+	var unique []string
+	for _, address := range addresses {
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		unique = append(unique, address)
+	}
+	return unique
 }
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func TestUniqueAddresses(t *testing.T) {
+	addresses := UniqueAddresses([]string{"f1830cb81484659a", "", "1654653399040a61", "f1830cb81484659a"})
+	require.Equal(t, []string{"f1830cb81484659a", "1654653399040a61"}, addresses)
+
+	require.Empty(t, UniqueAddresses([]string{""}))
+}
+
+func TestFetchAccountsData(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	getData := func(address string, height int64) (accountData, error) {
+		mu.Lock()
+		calls[address]++
+		mu.Unlock()
+
+		if address == "1654653399040a61" {
+			return accountData{}, fmt.Errorf("cannot run script")
+		}
+		return accountData{stakers: []types.StakerNodeId{types.NewStakerNodeId(address, "node")}}, nil
+	}
+
+	// Addresses of the transactions of a block, some of them involved in more than one transaction
+	txsAddresses := [][]string{
+		{"f1830cb81484659a", "1654653399040a61"},
+		{"f1830cb81484659a", "e467b9dd11fa00df"},
+		{"1654653399040a61", "e467b9dd11fa00df", "f1830cb81484659a"},
+	}
+	var addresses []string
+	for _, txAddresses := range txsAddresses {
+		addresses = append(addresses, txAddresses...)
+	}
+
+	data := fetchAccountsData(addresses, 10, getData)
+
+	// Each address is read once, and the failing one does not prevent the others from being returned
+	require.Equal(t, map[string]int{"f1830cb81484659a": 1, "1654653399040a61": 1, "e467b9dd11fa00df": 1}, calls)
+	require.Equal(t, []types.StakerNodeId{
+		types.NewStakerNodeId("f1830cb81484659a", "node"),
+		types.NewStakerNodeId("e467b9dd11fa00df", "node"),
+	}, data.stakers)
+}
@@ -69,7 +69,7 @@ func getDelegatorID(address string, height int64, client client.Proxy) (uint32,
 	//val,err:=cadence.NewValue(candanceAddress)
 	candenceArr := []cadence.Value{candanceAddress}
 
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return 0, err
 	}
@@ -105,7 +105,7 @@ func getDelegatorNodeID(address string, height int64, client client.Proxy) (stri
 	//val,err:=cadence.NewValue(candanceAddress)
 	candenceArr := []cadence.Value{candanceAddress}
 
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return "", err
 	}
@@ -101,7 +101,7 @@ func getLockedTokenAccountBalance(address string, height int64, client client.Pr
 	//val,err:=cadence.NewValue(candanceAddress)
 	candenceArr := []cadence.Value{candanceAddress}
 
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return 0, err
 	}
@@ -136,7 +136,7 @@ func getLockedTokenAccountUnlockLimit(address string, height int64, client clien
 	candenceArr := []cadence.Value{candanceAddress}
 
 	var limit uint64
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return 0, err
 	}
@@ -172,7 +172,7 @@ func getLockedTokenAccountAddress(address string, height int64, client client.Pr
 	//val,err:=cadence.NewValue(candanceAddress)
 	candenceArr := []cadence.Value{candanceAddress}
 
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return "", err
 	}
@@ -185,13 +185,32 @@ func getLockedTokenAccountAddress(address string, height int64, client client.Pr
 	return val.String(), nil
 }
 
-// getDelegatorNodeInfo get delegator info associated to the address
+// getDelegatorNodeInfo get delegator info associated to the address, either directly, through its locked account
+// or through its FlowStakingCollection
 func getDelegatorNodeInfo(address string, height int64, client client.Proxy) ([]types.DelegatorNodeInfo, error) {
+	stakingCollection, err := hasStakingCollection(height, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// FlowStakingCollection cannot be imported at the heights preceding its deployment
+	var stakingCollectionImport, stakingCollectionDelegators string
+	if stakingCollection {
+		stakingCollectionImport = fmt.Sprintf("import FlowStakingCollection from %s", client.Contract().StakingCollection)
+		stakingCollectionDelegators = `
+		if FlowStakingCollection.doesAccountHaveStakingCollection(address: account) {
+			for ids in FlowStakingCollection.getDelegatorIDs(address: account) {
+				delegatorInfoArray.append(FlowIDTableStaking.DelegatorInfo(nodeID: ids.delegatorNodeID, delegatorID: ids.delegatorID))
+			}
+		}`
+	}
+
 	script := fmt.Sprintf(`
 	import FlowIDTableStaking from %s
 	import LockedTokens from %s
+	%s
 	// Returns an array of DelegatorInfo objects that the account controls
-	// in its normal account and shared account
+	// in its normal account, shared account and staking collection
 	pub fun main(account: Address): [FlowIDTableStaking.DelegatorInfo] {
 		let delegatorInfoArray: [FlowIDTableStaking.DelegatorInfo] = []
 		let pubAccount = getAccount(account)
@@ -200,6 +219,7 @@ func getDelegatorNodeInfo(address string, height int64, client client.Proxy) ([]
 		if let delegatorRef = delegator {
 			delegatorInfoArray.append(FlowIDTableStaking.DelegatorInfo(nodeID: delegatorRef.nodeID, delegatorID: delegatorRef.id))
 		}
+		%s
 		let lockedAccountInfoCap = pubAccount
 			.getCapability
 			<&LockedTokens.TokenHolder{LockedTokens.LockedAccountInfo}>
@@ -217,14 +237,14 @@ func getDelegatorNodeInfo(address string, height int64, client client.Proxy) ([]
 			delegatorInfoArray.append(FlowIDTableStaking.DelegatorInfo(nodeID: nodeID!, delegatorID: delegatorID!))
 		}
 		return delegatorInfoArray
-	}`, client.Contract().StakingTable, client.Contract().LockedTokens)
+	}`, client.Contract().StakingTable, client.Contract().LockedTokens, stakingCollectionImport, stakingCollectionDelegators)
 
 	flowAddress := flow.HexToAddress(address)
 	candanceAddress := cadence.Address(flowAddress)
 	//val,err:=cadence.NewValue(candanceAddress)
 	candenceArr := []cadence.Value{candanceAddress}
 
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return nil, err
 	}
@@ -234,5 +254,25 @@ func getDelegatorNodeInfo(address string, height int64, client client.Proxy) ([]
 		return nil, err
 	}
 
-	return nodeInfos, nil
+	// The staking collection also contains the delegator of the locked account, if any
+	return uniqueDelegators(nodeInfos), nil
+}
+
+// uniqueDelegators returns the given delegators without duplicates, preserving their order
+func uniqueDelegators(delegators []types.DelegatorNodeInfo) []types.DelegatorNodeInfo {
+	type delegatorKey struct {
+		nodeID string
+		id     uint32
+	}
+
+	seen := make(map[delegatorKey]bool, len(delegators))
+	var unique []types.DelegatorNodeInfo
+	for _, delegator := range delegators {
+		key := delegatorKey{delegator.NodeID, delegator.Id}
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, delegator)
+		}
+	}
+	return unique
 }
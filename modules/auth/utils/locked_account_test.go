@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
 	"github.com/HarleyAppleChoi/junomum/types"
 )
 
@@ -55,3 +59,19 @@ func (suite *AuthProxyTestSuite) TestProxy_getDelegatorNodeInfo() {
 	suite.Require().Equal(uint32(3905), nodeInfo[0].Id)
 
 }
+
+func TestUniqueDelegators(t *testing.T) {
+	delegators := uniqueDelegators([]types.DelegatorNodeInfo{
+		{NodeID: "node-1", Id: 1, TokensStaked: 10},
+		{NodeID: "node-1", Id: 2},
+		{NodeID: "node-2", Id: 1},
+		{NodeID: "node-1", Id: 1, TokensStaked: 10},
+	})
+	require.Equal(t, []types.DelegatorNodeInfo{
+		{NodeID: "node-1", Id: 1, TokensStaked: 10},
+		{NodeID: "node-1", Id: 2},
+		{NodeID: "node-2", Id: 1},
+	}, delegators)
+
+	require.Empty(t, uniqueDelegators(nil))
+}
@@ -40,13 +40,30 @@ func GetStakerAccounts(addresses []string, height int64, client client.Proxy) ([
 	return stakerAccounts, nil
 }
 
-// getStakerNodeId get node ids that the address have control on it
+// getStakerNodeId get node ids that the address have control on it, either directly, through its locked account
+// or through its FlowStakingCollection
 func getStakerNodeId(address string, height int64, client client.Proxy) ([]string, error) {
+	stakingCollection, err := hasStakingCollection(height, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// FlowStakingCollection cannot be imported at the heights preceding its deployment
+	var stakingCollectionImport, stakingCollectionNodes string
+	if stakingCollection {
+		stakingCollectionImport = fmt.Sprintf("import FlowStakingCollection from %s", client.Contract().StakingCollection)
+		stakingCollectionNodes = `
+		if FlowStakingCollection.doesAccountHaveStakingCollection(address: account) {
+			nodeInfoArray.appendAll(FlowStakingCollection.getNodeIDs(address: account))
+		}`
+	}
+
 	script := fmt.Sprintf(`
 	import FlowIDTableStaking from %s
 	import LockedTokens from %s
+	%s
 	// Returns an array of node_info_id string that the account controls
-	// in its normal account and shared account
+	// in its normal account, shared account and staking collection
 	
 	pub fun main(account: Address): [String] {
 	
@@ -60,6 +77,7 @@ func getStakerNodeId(address string, height int64, client client.Proxy) ([]strin
 		if let nodeRef = nodeStaker {
 			nodeInfoArray.append(nodeRef.id!)
 		}
+		%s
 	
 		let lockedAccountInfoCap = pubAccount
 			.getCapability
@@ -80,14 +98,14 @@ func getStakerNodeId(address string, height int64, client client.Proxy) ([]strin
 		}
 				
 		return nodeInfoArray
-     }`, client.Contract().StakingTable, client.Contract().LockedTokens)
+     }`, client.Contract().StakingTable, client.Contract().LockedTokens, stakingCollectionImport, stakingCollectionNodes)
 
 	flowAddress := flow.HexToAddress(address)
 	candanceAddress := cadence.Address(flowAddress)
 	//val,err:=cadence.NewValue(candanceAddress)
 	candenceArr := []cadence.Value{candanceAddress}
 
-	value, err := client.Client().ExecuteScriptAtLatestBlock(client.Ctx(), []byte(script), candenceArr)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), candenceArr)
 	if err != nil {
 		return nil, err
 	}
@@ -97,5 +115,19 @@ func getStakerNodeId(address string, height int64, client client.Proxy) ([]strin
 		return nil, err
 	}
 
-	return stakerNodeInfo, nil
+	// The staking collection also contains the node of the locked account, if any
+	return uniqueNodeIDs(stakerNodeInfo), nil
+}
+
+// uniqueNodeIDs returns the given node ids without duplicates, preserving their order
+func uniqueNodeIDs(nodeIDs []string) []string {
+	seen := make(map[string]bool, len(nodeIDs))
+	var unique []string
+	for _, nodeID := range nodeIDs {
+		if !seen[nodeID] {
+			seen[nodeID] = true
+			unique = append(unique, nodeID)
+		}
+	}
+	return unique
 }
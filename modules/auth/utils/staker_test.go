@@ -1,5 +1,11 @@
 package utils
 
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
 func (suite *AuthProxyTestSuite) TestProxy_getStakerNodeId() {
 	proxy := *suite.Proxy
 	height, err := proxy.LatestHeight()
@@ -9,3 +15,11 @@ func (suite *AuthProxyTestSuite) TestProxy_getStakerNodeId() {
 
 	suite.Require().Equal(nodeIds[0], "e7df1454826425251716a703e907981672a43208ef3eabfc95d593673da778f6")
 }
+
+func TestUniqueNodeIDs(t *testing.T) {
+	// The node of the locked account is listed again by the staking collection
+	nodeIDs := uniqueNodeIDs([]string{"node-1", "node-2", "node-1"})
+	require.Equal(t, []string{"node-1", "node-2"}, nodeIDs)
+
+	require.Empty(t, uniqueNodeIDs(nil))
+}
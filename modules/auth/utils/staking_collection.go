@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+)
+
+// stakingCollectionContract is the name of the contract allowing accounts to manage all their stakes at once
+const stakingCollectionContract = "FlowStakingCollection"
+
+// deploymentCache keeps track of the heights at which a contract is known to be deployed or not.
+// Contracts are never removed once deployed, so a single check tells about all the heights before or after it
+type deploymentCache struct {
+	mu sync.Mutex
+
+	deployedFrom int64 // lowest height at which the contract is known to be deployed, 0 if unknown
+	missingUntil int64 // highest height at which the contract is known not to be deployed
+}
+
+// get tells whether the contract is deployed at the given height, if known
+func (c *deploymentCache) get(height int64) (deployed bool, known bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.deployedFrom != 0 && height >= c.deployedFrom {
+		return true, true
+	}
+	if height <= c.missingUntil {
+		return false, true
+	}
+	return false, false
+}
+
+// set records whether the contract is deployed at the given height
+func (c *deploymentCache) set(height int64, deployed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if deployed && (c.deployedFrom == 0 || height < c.deployedFrom) {
+		c.deployedFrom = height
+	}
+	if !deployed && height > c.missingUntil {
+		c.missingUntil = height
+	}
+}
+
+var stakingCollectionDeployment = &deploymentCache{}
+
+// hasStakingCollection tells whether the FlowStakingCollection contract is deployed at the given height,
+// so that the scripts run at heights preceding its deployment do not import it
+func hasStakingCollection(height int64, client client.Proxy) (bool, error) {
+	if deployed, known := stakingCollectionDeployment.get(height); known {
+		return deployed, nil
+	}
+
+	address := flow.HexToAddress(client.Contract().StakingCollection)
+	account, err := client.Client().GetAccountAtBlockHeight(client.Ctx(), address, uint64(height))
+	if err != nil {
+		return false, fmt.Errorf("error while getting %s account: %s", stakingCollectionContract, err)
+	}
+
+	_, deployed := account.Contracts[stakingCollectionContract]
+	stakingCollectionDeployment.set(height, deployed)
+	return deployed, nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentCache(t *testing.T) {
+	cache := &deploymentCache{}
+
+	_, known := cache.get(100)
+	require.False(t, known)
+
+	// Heights preceding one at which the contract is missing are missing as well
+	cache.set(100, false)
+	deployed, known := cache.get(50)
+	require.True(t, known)
+	require.False(t, deployed)
+
+	_, known = cache.get(150)
+	require.False(t, known)
+
+	// Heights following one at which the contract is deployed have it as well
+	cache.set(200, true)
+	deployed, known = cache.get(300)
+	require.True(t, known)
+	require.True(t, deployed)
+
+	_, known = cache.get(150)
+	require.False(t, known)
+
+	cache.set(150, true)
+	deployed, known = cache.get(150)
+	require.True(t, known)
+	require.True(t, deployed)
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// GetAccountStorage returns the storage used and the storage capacity of the given addresses at the given height
+func GetAccountStorage(addresses []string, height int64, client client.Proxy) ([]types.AccountStorage, error) {
+	script := `
+	pub fun main(account: Address): [UInt64] {
+		let pubAccount = getAccount(account)
+		return [pubAccount.storageUsed, pubAccount.storageCapacity]
+	}`
+
+	var storages []types.AccountStorage
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+
+		arguments := []cadence.Value{cadence.Address(flow.HexToAddress(address))}
+		value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), arguments)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting account storage of %s: %s", address, err)
+		}
+
+		storage, err := types.NewAccountStorageFromCadence(address, value, uint64(height))
+		if err != nil {
+			return nil, err
+		}
+
+		storages = append(storages, storage)
+	}
+
+	return storages, nil
+}
@@ -0,0 +1,38 @@
+package balances
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	balancesutils "github.com/HarleyAppleChoi/junomum/modules/balances/utils"
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// HandleTx stores the token balances of all the accounts involved in the given transaction
+func HandleTx(
+	getAddresses messages.MessageAddressesParser, cdc codec.Marshaler, tokens []config.FungibleTokenConfig,
+	db *db.Db, flowClient client.Proxy, tx *types.Tx,
+) error {
+	arguments, err := tx.DecodedArguments()
+	if err != nil {
+		// Fall back to the addresses that can be found without the arguments
+		log.Debug().Str("module", "balances").Str("tx", tx.TransactionID).Err(err).Msg("cannot decode arguments")
+		arguments = nil
+	}
+
+	addresses, err := getAddresses(cdc, *tx, tx.Events, arguments)
+	if err != nil {
+		return err
+	}
+
+	balances, err := balancesutils.GetAccountTokenBalances(addresses, tokens, int64(tx.Height), flowClient)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveAccountTokenBalances(balances)
+}
@@ -0,0 +1,56 @@
+package balances
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+)
+
+// Module represents the module that keeps track of the fungible token balances of the accounts
+type Module struct {
+	messagesParser messages.MessageAddressesParser
+	encodingConfig *params.EncodingConfig
+	flowClient     client.Proxy
+	db             *db.Db
+	tokens         []config.FungibleTokenConfig
+}
+
+// NewModule builds a new Module instance
+func NewModule(
+	cfg *config.TokenConfig,
+	messagesParser messages.MessageAddressesParser,
+	flowClient client.Proxy,
+	encodingConfig *params.EncodingConfig, db *db.Db,
+) *Module {
+	tokens := append([]config.FungibleTokenConfig{
+		config.FlowTokenConfig(flowClient.Contract().FlowToken),
+	}, cfg.GetFungibleTokens()...)
+
+	return &Module{
+		messagesParser: messagesParser,
+		encodingConfig: encodingConfig,
+		flowClient:     flowClient,
+		db:             db,
+		tokens:         tokens,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "balances"
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(index int, tx *types.Tx) error {
+	return HandleTx(m.messagesParser, m.encodingConfig.Marshaler, m.tokens, m.db, m.flowClient, tx)
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// GetBalancesScript returns the script that reads the balances of all the given tokens held by an account.
+// Tokens whose balance capability is not exposed by the account are not included inside the result
+func GetBalancesScript(tokens []config.FungibleTokenConfig, fungibleTokenAddress string) string {
+	var balances strings.Builder
+	for _, token := range tokens {
+		balances.WriteString(fmt.Sprintf(`
+		if let vault = account.getCapability(%s).borrow<&{FungibleToken.Balance}>() {
+			balances["%s"] = vault.balance
+		}
+		`, token.BalancePath, token.GetIdentifier()))
+	}
+
+	return fmt.Sprintf(`
+	import FungibleToken from %s
+
+	pub fun main(address: Address): {String: UFix64} {
+		let account = getAccount(address)
+		let balances: {String: UFix64} = {}
+		%s
+		return balances
+	}`, fungibleTokenAddress, balances.String())
+}
+
+// GetAccountTokenBalances returns the balances of the given tokens held by the given addresses at the given height
+func GetAccountTokenBalances(
+	addresses []string, tokens []config.FungibleTokenConfig, height int64, client client.Proxy,
+) ([]types.AccountTokenBalance, error) {
+	script := GetBalancesScript(tokens, client.Contract().FungibleToken)
+
+	var balances []types.AccountTokenBalance
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+
+		value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script),
+			[]cadence.Value{cadence.Address(flow.HexToAddress(address))})
+		if err != nil {
+			return nil, fmt.Errorf("error while getting token balances of %s: %s", address, err)
+		}
+
+		dictionary, ok := value.(cadence.Dictionary)
+		if !ok {
+			return nil, fmt.Errorf("cadence value is not a dictionary: %s", value)
+		}
+
+		for _, pair := range dictionary.Pairs {
+			token, err := utils.CadanceConvertString(pair.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			balance, err := utils.CadenceConvertUint64(pair.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			balances = append(balances, types.NewAccountTokenBalance(address, token, balance, uint64(height)))
+		}
+	}
+
+	return balances, nil
+}
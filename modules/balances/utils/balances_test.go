@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+func (suite *BalancesProxyTestSuite) TestProxy_GetAccountTokenBalances() {
+	proxy := *suite.Proxy
+	height, err := proxy.LatestHeight()
+	suite.Require().NoError(err)
+
+	tokens := []config.FungibleTokenConfig{
+		config.FlowTokenConfig(proxy.Contract().FlowToken),
+		config.NewFungibleTokenConfig("FUSD", "0x3c5959b568896393", "/public/fusdBalance"),
+	}
+
+	balances, err := GetAccountTokenBalances([]string{"f919ee77447b7497"}, tokens, height, proxy)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(balances)
+	suite.Require().Equal(tokens[0].GetIdentifier(), balances[0].Token)
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	testutils "github.com/HarleyAppleChoi/junomum/modules/utils"
+)
+
+func TestBalancesProxyTestSuite(t *testing.T) {
+	suite.Run(t, new(BalancesProxyTestSuite))
+}
+
+// BalancesProxyTestSuite the base test case class for Balances module
+type BalancesProxyTestSuite struct {
+	testutils.ProxyTestSuite
+}
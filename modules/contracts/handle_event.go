@@ -0,0 +1,36 @@
+package contracts
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	contractsutils "github.com/HarleyAppleChoi/junomum/modules/contracts/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleEvent stores a new contract version each time a contract is added, updated or removed
+func HandleEvent(event types.Event, db *db.Db, flowClient client.Proxy) error {
+	version, ok, err := contractsutils.ParseContractEvent(event)
+	if err != nil || !ok {
+		return err
+	}
+
+	log.Debug().Str("module", "contracts").Str("address", version.Address).Str("contract", version.Name).
+		Str("action", version.Action).Msg("contract changed")
+
+	if version.Action != types.ContractActionRemoved {
+		source, err := contractsutils.GetContractSource(version.Address, version.Name, int64(version.Height), flowClient)
+		if err != nil {
+			return err
+		}
+
+		// The account state is the one at the end of the block, so the source could belong to
+		// a later version if the contract has been updated more than once inside the same block
+		if contractsutils.CodeHash(source) == version.CodeHash {
+			version.Source = string(source)
+		}
+	}
+
+	return db.SaveContractVersions([]types.ContractVersion{version})
+}
@@ -0,0 +1,37 @@
+package contracts
+
+import (
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module        = &Module{}
+	_ modules.MessageModule = &Module{}
+)
+
+// Module represents the module that keeps track of all the versions of the deployed contracts
+type Module struct {
+	flowClient client.Proxy
+	db         *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		flowClient: flowClient,
+		db:         db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "contracts"
+}
+
+// HandleEvent implements modules.MessageModule
+func (m *Module) HandleEvent(index int, event types.Event, tx *types.Tx) error {
+	return HandleEvent(event, m.db, m.flowClient)
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// contractActions maps the type of each contract event to the action it represents
+var contractActions = map[string]string{
+	flow.EventAccountContractAdded:   types.ContractActionAdded,
+	flow.EventAccountContractUpdated: types.ContractActionUpdated,
+	flow.EventAccountContractRemoved: types.ContractActionRemoved,
+}
+
+// ParseContractEvent returns the contract version described by the given flow.AccountContractAdded,
+// flow.AccountContractUpdated or flow.AccountContractRemoved event, without its source.
+// It returns false if the given event is not a contract event
+func ParseContractEvent(event types.Event) (types.ContractVersion, bool, error) {
+	action, ok := contractActions[event.Type]
+	if !ok {
+		return types.ContractVersion{}, false, nil
+	}
+
+	addressValue, ok := event.Field("address")
+	address, isAddress := addressValue.(cadence.Address)
+	if !ok || !isAddress {
+		return types.ContractVersion{}, false, fmt.Errorf("invalid address of event %s: %s", event.Type, addressValue)
+	}
+
+	nameValue, ok := event.Field("contract")
+	if !ok {
+		return types.ContractVersion{}, false, fmt.Errorf("missing contract of event %s", event.Type)
+	}
+
+	name, err := utils.CadanceConvertString(nameValue)
+	if err != nil {
+		return types.ContractVersion{}, false, err
+	}
+
+	codeHashValue, ok := event.Field("codeHash")
+	codeHash, isArray := codeHashValue.(cadence.Array)
+	if !ok || !isArray {
+		return types.ContractVersion{}, false, fmt.Errorf("invalid code hash of event %s: %s", event.Type, codeHashValue)
+	}
+
+	hash := make([]byte, len(codeHash.Values))
+	for i, value := range codeHash.Values {
+		hash[i], err = utils.CadenceConvertUint8(value)
+		if err != nil {
+			return types.ContractVersion{}, false, err
+		}
+	}
+
+	return types.NewContractVersion(
+		address.Hex(), name, action, hex.EncodeToString(hash), "",
+		event.TransactionID, uint64(event.Height), event.EventIndex,
+	), true, nil
+}
+
+// CodeHash returns the hex encoded SHA3-256 hash of the given contract source,
+// which is the same hash included inside the contract events
+func CodeHash(source []byte) string {
+	return hex.EncodeToString(crypto.NewSHA3_256().ComputeHash(source))
+}
+
+// GetContractSource returns the source of the contract having the given name deployed on the given account
+// at the given height, or nil if the account does not contain such contract
+func GetContractSource(address string, name string, height int64, client client.Proxy) ([]byte, error) {
+	account, err := client.Client().GetAccountAtBlockHeight(client.Ctx(), flow.HexToAddress(address), uint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account %s: %s", address, err)
+	}
+
+	return account.Contracts[name], nil
+}
@@ -0,0 +1,39 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/contracts/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+func contractEvent(eventType string, codeHash []byte) types.Event {
+	return testutil.NewEvent(10, eventType, 2,
+		testutil.AddressField("address", []byte{0x01}),
+		testutil.BytesField("codeHash", codeHash),
+		testutil.StringField("contract", "Hello"),
+	)
+}
+
+func TestParseContractEvent(t *testing.T) {
+	version, ok, err := utils.ParseContractEvent(contractEvent("flow.AccountContractUpdated", []byte{0xab, 0xcd}))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, types.NewContractVersion(
+		"0000000000000001", "Hello", types.ContractActionUpdated, "abcd", "", "0x6", 10, 2,
+	), version)
+
+	_, ok, err = utils.ParseContractEvent(contractEvent("flow.AccountCreated", nil))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestCodeHash(t *testing.T) {
+	require.Equal(t,
+		"a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
+		utils.CodeHash([]byte{}),
+	)
+}
@@ -0,0 +1,52 @@
+package epoch
+
+import (
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	epochutils "github.com/HarleyAppleChoi/junomum/modules/epoch/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleSystemEvents stores the epoch phases beginning inside the given block, along with the setup and commit
+// payloads of the upcoming epoch. The FlowEpoch service events are emitted by the system chunk transaction,
+// so they are fetched by type along with the other system events instead of being read from the block transactions
+func HandleSystemEvents(block *flow.Block, events []types.Event, db *db.Db) error {
+	var phases []types.EpochPhase
+	for _, event := range events {
+		phase, err := handleEpochEvent(event, block, db)
+		if err != nil {
+			return err
+		}
+		phases = append(phases, phase)
+	}
+
+	return db.SaveEpochPhases(phases)
+}
+
+// handleEpochEvent stores the payload of the given FlowEpoch event, if any,
+// and returns the epoch phase that begins with it
+func handleEpochEvent(event types.Event, block *flow.Block, db *db.Db) (types.EpochPhase, error) {
+	log.Debug().Str("module", "epoch").Uint64("height", block.Height).
+		Str("event", event.Name()).Msg("epoch event")
+
+	switch event.Name() {
+	case epochutils.EpochSetupEvent:
+		setup, phase, err := epochutils.ParseEpochSetup(event, block.Timestamp)
+		if err != nil {
+			return types.EpochPhase{}, err
+		}
+		return phase, db.SaveEpochSetup(setup)
+
+	case epochutils.EpochCommitEvent:
+		commit, phase, err := epochutils.ParseEpochCommit(event, block.Timestamp)
+		if err != nil {
+			return types.EpochPhase{}, err
+		}
+		return phase, db.SaveEpochCommit(commit)
+
+	default:
+		return epochutils.ParseEpochStart(event, block.Timestamp)
+	}
+}
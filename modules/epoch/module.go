@@ -0,0 +1,45 @@
+package epoch
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	epochutils "github.com/HarleyAppleChoi/junomum/modules/epoch/utils"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module             = &Module{}
+	_ modules.SystemEventsModule = &Module{}
+)
+
+// Module represents the module that keeps track of the epochs and of their phases
+type Module struct {
+	flowClient client.Proxy
+	db         *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		flowClient: flowClient,
+		db:         db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "epoch"
+}
+
+// SystemEventTypes implements modules.SystemEventsModule
+func (m *Module) SystemEventTypes() []string {
+	return epochutils.GetEpochEventTypes(m.flowClient.Contract().FlowEpoch)
+}
+
+// HandleSystemEvents implements modules.SystemEventsModule
+func (m *Module) HandleSystemEvents(block *flow.Block, events []types.Event) error {
+	return HandleSystemEvents(block, events, m.db)
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+const (
+	EpochStartEvent  = "EpochStart"
+	EpochSetupEvent  = "EpochSetup"
+	EpochCommitEvent = "EpochCommit"
+)
+
+// GetEpochEventTypes returns the types of the events emitted by the FlowEpoch contract deployed at the given
+// address when an epoch starts, when its successor is set up and when its successor is committed
+func GetEpochEventTypes(address string) []string {
+	prefix := fmt.Sprintf("A.%s.FlowEpoch.", strings.TrimPrefix(address, "0x"))
+	return []string{
+		prefix + EpochStartEvent,
+		prefix + EpochSetupEvent,
+		prefix + EpochCommitEvent,
+	}
+}
+
+// ParseEpochStart returns the staking phase that begins with the given EpochStart event
+func ParseEpochStart(event types.Event, timestamp time.Time) (types.EpochPhase, error) {
+	counter, err := getCounter(event)
+	if err != nil {
+		return types.EpochPhase{}, err
+	}
+
+	return types.NewEpochPhase(counter, types.EpochPhaseStaking, uint64(event.Height), timestamp), nil
+}
+
+// ParseEpochSetup returns the setup of the upcoming epoch contained inside the given EpochSetup event,
+// along with the setup phase of the current epoch that begins with it.
+// The identity table is stored apart from the other fields of the event
+func ParseEpochSetup(event types.Event, timestamp time.Time) (types.EpochSetup, types.EpochPhase, error) {
+	counter, err := getCounter(event)
+	if err != nil {
+		return types.EpochSetup{}, types.EpochPhase{}, err
+	}
+
+	firstView, err := getUint64Field(event, "firstView")
+	if err != nil {
+		return types.EpochSetup{}, types.EpochPhase{}, err
+	}
+
+	finalView, err := getUint64Field(event, "finalView")
+	if err != nil {
+		return types.EpochSetup{}, types.EpochPhase{}, err
+	}
+
+	var randomSource string
+	if value, ok := event.Field("randomSource"); ok {
+		randomSource, err = utils.CadanceConvertString(value)
+		if err != nil {
+			return types.EpochSetup{}, types.EpochPhase{}, err
+		}
+	}
+
+	var nodes interface{} = []interface{}{}
+	if value, ok := event.Field("nodeInfo"); ok {
+		nodes = types.FlattenCadenceValue(value)
+	}
+
+	nodesJSON, err := json.Marshal(nodes)
+	if err != nil {
+		return types.EpochSetup{}, types.EpochPhase{}, err
+	}
+
+	payload, ok := types.FlattenCadenceValue(event.Value).(map[string]interface{})
+	if !ok {
+		return types.EpochSetup{}, types.EpochPhase{}, fmt.Errorf("invalid payload of event %s", event.Type)
+	}
+
+	delete(payload, "nodeInfo")
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return types.EpochSetup{}, types.EpochPhase{}, err
+	}
+
+	setup := types.NewEpochSetup(counter, firstView, finalView, randomSource, nodesJSON, payloadJSON)
+	phase := types.NewEpochPhase(counter-1, types.EpochPhaseSetup, uint64(event.Height), timestamp)
+	return setup, phase, nil
+}
+
+// ParseEpochCommit returns the commit of the upcoming epoch contained inside the given EpochCommit event,
+// along with the committed phase of the current epoch that begins with it
+func ParseEpochCommit(event types.Event, timestamp time.Time) (types.EpochCommit, types.EpochPhase, error) {
+	counter, err := getCounter(event)
+	if err != nil {
+		return types.EpochCommit{}, types.EpochPhase{}, err
+	}
+
+	payload, err := json.Marshal(types.FlattenCadenceValue(event.Value))
+	if err != nil {
+		return types.EpochCommit{}, types.EpochPhase{}, err
+	}
+
+	commit := types.NewEpochCommit(counter, payload)
+	phase := types.NewEpochPhase(counter-1, types.EpochPhaseCommitted, uint64(event.Height), timestamp)
+	return commit, phase, nil
+}
+
+// getCounter returns the epoch counter contained inside the given event.
+// The setup and commit events always refer to an epoch following another one, so their counter is never zero
+func getCounter(event types.Event) (uint64, error) {
+	counter, err := getUint64Field(event, "counter")
+	if err != nil {
+		return 0, err
+	}
+
+	if counter == 0 && event.Name() != EpochStartEvent {
+		return 0, fmt.Errorf("invalid counter inside %s event", event.Type)
+	}
+
+	return counter, nil
+}
+
+// getUint64Field returns the value of the given UInt64 field of the event
+func getUint64Field(event types.Event, field string) (uint64, error) {
+	value, ok := event.Field(field)
+	if !ok {
+		return 0, fmt.Errorf("%s event does not contain the %s field", event.Type, field)
+	}
+	return utils.CadenceConvertUint64(value)
+}
@@ -0,0 +1,85 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/epoch/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+var (
+	timestamp  = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventTypes = utils.GetEpochEventTypes("0x8624b52f9ddcd04a")
+)
+
+func TestGetEpochEventTypes(t *testing.T) {
+	require.Equal(t, []string{
+		"A.8624b52f9ddcd04a.FlowEpoch.EpochStart",
+		"A.8624b52f9ddcd04a.FlowEpoch.EpochSetup",
+		"A.8624b52f9ddcd04a.FlowEpoch.EpochCommit",
+	}, eventTypes)
+}
+
+func TestParseEpochStart(t *testing.T) {
+	event := testutil.NewEvent(100, eventTypes[0], 0, testutil.UInt64Field("counter", 2))
+
+	phase, err := utils.ParseEpochStart(event, timestamp)
+	require.NoError(t, err)
+	require.True(t, phase.Equal(types.NewEpochPhase(2, types.EpochPhaseStaking, 100, timestamp)))
+}
+
+func TestParseEpochSetup(t *testing.T) {
+	nodeType := &cadence.StructType{
+		QualifiedIdentifier: "FlowIDTableStaking.NodeInfo",
+		Fields: []cadence.Field{
+			{Identifier: "id", Type: cadence.StringType{}},
+			{Identifier: "role", Type: cadence.UInt8Type{}},
+		},
+	}
+	nodes := cadence.NewArray([]cadence.Value{
+		cadence.NewStruct([]cadence.Value{cadence.NewString("node-1"), cadence.NewUInt8(1)}).WithType(nodeType),
+	})
+
+	event := testutil.NewEvent(100, eventTypes[1], 0,
+		testutil.UInt64Field("counter", 2),
+		testutil.NewEventField("nodeInfo", cadence.VariableSizedArrayType{ElementType: nodeType}, nodes),
+		testutil.UInt64Field("firstView", 1000),
+		testutil.UInt64Field("finalView", 2000),
+		testutil.StringField("randomSource", "abcd"),
+	)
+
+	setup, phase, err := utils.ParseEpochSetup(event, timestamp)
+	require.NoError(t, err)
+	require.True(t, setup.Equal(types.NewEpochSetup(2, 1000, 2000, "abcd",
+		[]byte(`[{"id":"node-1","role":1}]`),
+		[]byte(`{"counter":2,"finalView":2000,"firstView":1000,"randomSource":"abcd"}`),
+	)), string(setup.Nodes)+" "+string(setup.Payload))
+	require.True(t, phase.Equal(types.NewEpochPhase(1, types.EpochPhaseSetup, 100, timestamp)))
+}
+
+func TestParseEpochCommit(t *testing.T) {
+	keysType := cadence.VariableSizedArrayType{ElementType: cadence.StringType{}}
+
+	event := testutil.NewEvent(100, eventTypes[2], 0,
+		testutil.UInt64Field("counter", 2),
+		testutil.NewEventField("dkgPubKeys", keysType, cadence.NewArray([]cadence.Value{cadence.NewString("key")})),
+	)
+
+	commit, phase, err := utils.ParseEpochCommit(event, timestamp)
+	require.NoError(t, err)
+	require.True(t, commit.Equal(types.NewEpochCommit(2, []byte(`{"counter":2,"dkgPubKeys":["key"]}`))))
+	require.True(t, phase.Equal(types.NewEpochPhase(1, types.EpochPhaseCommitted, 100, timestamp)))
+
+	// Setup and commit events always refer to an epoch following another one
+	event = testutil.NewEvent(100, eventTypes[2], 0,
+		testutil.UInt64Field("counter", 0),
+		testutil.NewEventField("dkgPubKeys", keysType, cadence.NewArray(nil)),
+	)
+	_, _, err = utils.ParseEpochCommit(event, timestamp)
+	require.Error(t, err)
+}
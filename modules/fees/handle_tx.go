@@ -0,0 +1,25 @@
+package fees
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	feesutils "github.com/HarleyAppleChoi/junomum/modules/fees/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleTx stores the fees paid by the payer of the given transaction,
+// updating the daily aggregates of the payer
+func HandleTx(db *db.Db, tx *types.Tx, flowClient client.Proxy) error {
+	flowFees := feesutils.GetFlowFeesIdentifier(flowClient.Contract().FlowFee)
+	fee, err := feesutils.GetTransactionFee(*tx, flowFees)
+	if err != nil || fee == nil {
+		return err
+	}
+
+	log.Debug().Str("module", "fees").Str("tx", tx.TransactionID).
+		Uint64("amount", fee.Amount).Msg("transaction fee")
+
+	return db.SaveTransactionFees([]types.TransactionFee{*fee})
+}
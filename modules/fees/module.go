@@ -0,0 +1,37 @@
+package fees
+
+import (
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+)
+
+// Module represents the module that keeps track of the fees paid by each transaction
+type Module struct {
+	flowClient client.Proxy
+	db         *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		flowClient: flowClient,
+		db:         db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "fees"
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(index int, tx *types.Tx) error {
+	return HandleTx(m.db, tx, m.flowClient)
+}
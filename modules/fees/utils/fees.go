@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// GetFlowFeesIdentifier returns the identifier used as events prefix by the FlowFees contract
+// deployed at the given address, eg. A.f919ee77447b7497.FlowFees
+func GetFlowFeesIdentifier(address string) string {
+	return fmt.Sprintf("A.%s.FlowFees", strings.TrimPrefix(address, "0x"))
+}
+
+// GetTransactionFee returns the fees paid by the payer of the given transaction, reading the events
+// emitted by the FlowFees contract having the given identifier. The FeesDeducted event is used when
+// present, otherwise the fees are the sum of the TokensDeposited events.
+// It returns nil if the transaction did not emit any fee event
+func GetTransactionFee(tx types.Tx, flowFees string) (*types.TransactionFee, error) {
+	var deposited uint64
+	var found bool
+	for _, event := range tx.Events {
+		if !strings.HasPrefix(event.Type, flowFees+".") {
+			continue
+		}
+
+		switch event.Name() {
+		case "FeesDeducted":
+			amount, err := getUFix64Field(event, "amount")
+			if err != nil {
+				return nil, err
+			}
+
+			inclusionEffort, err := getUFix64Field(event, "inclusionEffort")
+			if err != nil {
+				return nil, err
+			}
+
+			executionEffort, err := getUFix64Field(event, "executionEffort")
+			if err != nil {
+				return nil, err
+			}
+
+			fee := types.NewTransactionFee(tx.TransactionID, tx.Height, tx.Payer, amount, inclusionEffort, executionEffort)
+			return &fee, nil
+
+		case "TokensDeposited":
+			amount, err := getUFix64Field(event, "amount")
+			if err != nil {
+				return nil, err
+			}
+
+			deposited += amount
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	fee := types.NewTransactionFee(tx.TransactionID, tx.Height, tx.Payer, deposited, 0, 0)
+	return &fee, nil
+}
+
+// getUFix64Field returns the value of the given UFix64 field of the event, or zero if the event does not have it
+func getUFix64Field(event types.Event, field string) (uint64, error) {
+	value, ok := event.Field(field)
+	if !ok {
+		return 0, nil
+	}
+	return utils.CadenceConvertUint64(value)
+}
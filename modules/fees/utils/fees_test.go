@@ -0,0 +1,48 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/fees/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+var flowFees = utils.GetFlowFeesIdentifier("0xf919ee77447b7497")
+
+func feesEvent(name string, fields map[string]uint64, order ...string) types.Event {
+	eventFields := make([]testutil.EventField, len(order))
+	for i, field := range order {
+		eventFields[i] = testutil.UFix64Field(field, fields[field])
+	}
+	return testutil.NewEvent(10, flowFees+"."+name, 0, eventFields...)
+}
+
+func TestGetTransactionFee(t *testing.T) {
+	tx := types.NewTx(10, "0x6", nil, nil, "0x2", 100, "", "0000000000000001", nil, nil, nil)
+
+	// No fee events
+	fee, err := utils.GetTransactionFee(tx, flowFees)
+	require.NoError(t, err)
+	require.Nil(t, fee)
+
+	// Only the deposits of the fees
+	tx.Events = []types.Event{
+		feesEvent("TokensDeposited", map[string]uint64{"amount": 1000}, "amount"),
+		feesEvent("TokensDeposited", map[string]uint64{"amount": 500}, "amount"),
+	}
+	fee, err = utils.GetTransactionFee(tx, flowFees)
+	require.NoError(t, err)
+	require.Equal(t, types.NewTransactionFee("0x6", 10, "0000000000000001", 1500, 0, 0), *fee)
+
+	// The deducted fees are preferred to the deposits
+	tx.Events = append(tx.Events, feesEvent("FeesDeducted",
+		map[string]uint64{"amount": 1000, "inclusionEffort": 100000000, "executionEffort": 20},
+		"amount", "inclusionEffort", "executionEffort",
+	))
+	fee, err = utils.GetTransactionFee(tx, flowFees)
+	require.NoError(t, err)
+	require.Equal(t, types.NewTransactionFee("0x6", 10, "0000000000000001", 1000, 100000000, 20), *fee)
+}
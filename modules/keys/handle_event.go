@@ -0,0 +1,52 @@
+package keys
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	keysutils "github.com/HarleyAppleChoi/junomum/modules/keys/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleEvent stores the addition or the revocation of an account key
+func HandleEvent(event types.Event, db *db.Db, flowClient client.Proxy) error {
+	keyEvent, ok, err := keysutils.ParseKeyEvent(event)
+	if err != nil || !ok {
+		return err
+	}
+
+	log.Debug().Str("module", "keys").Str("address", keyEvent.Address).Bool("removed", keyEvent.Removed).
+		Msg("account key changed")
+
+	recorded, err := db.GetRecordedAccountKeyIndexes(keyEvent.Address, keyEvent.Removed, event.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	key, err := keysutils.FindAccountKey(keyEvent, int64(event.Height), recorded, flowClient)
+	if err != nil {
+		return err
+	}
+
+	if key == nil {
+		// The periodic sync will still record the key from the account state
+		log.Warn().Str("module", "keys").Str("address", keyEvent.Address).Str("public_key", keyEvent.PublicKey).
+			Int("height", event.Height).Msg("cannot find account key, skipping")
+		return nil
+	}
+
+	var addedHeight, revokedHeight uint64
+	var addedTx, revokedTx string
+	if keyEvent.Removed {
+		revokedHeight, revokedTx = uint64(event.Height), event.TransactionID
+	} else {
+		addedHeight, addedTx = uint64(event.Height), event.TransactionID
+	}
+
+	history := types.NewAccountKeyHistory(
+		keyEvent.Address, key.Index, key.PublicKey.String(), key.SigAlgo.String(), key.HashAlgo.String(), key.Weight,
+		addedHeight, addedTx, revokedHeight, revokedTx,
+	)
+	return db.SaveAccountKeyHistory([]types.AccountKeyHistory{history})
+}
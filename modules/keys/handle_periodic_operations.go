@@ -0,0 +1,36 @@
+package keys
+
+import (
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+)
+
+// Register registers the utils that should be run periodically
+func Register(scheduler *gocron.Scheduler, db *database.Db, flowClient client.Proxy) error {
+	log.Debug().Str("module", "keys").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return syncKeyHistory(db, flowClient) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// syncKeyHistory completes the key history with the keys refreshed by the auth module,
+// so that keys changed inside blocks that have not been parsed are tracked as well.
+// The latest height is only stored as the one at which such changes have been observed
+func syncKeyHistory(db *database.Db, flowClient client.Proxy) error {
+	height, err := flowClient.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	log.Trace().Str("module", "keys").Int64("height", height).Msg("syncing key history")
+	return db.SyncAccountKeyHistory(height)
+}
@@ -0,0 +1,45 @@
+package keys
+
+import (
+	"github.com/go-co-op/gocron"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that keeps track of the history of the accounts keys
+type Module struct {
+	flowClient client.Proxy
+	db         *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		flowClient: flowClient,
+		db:         db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "keys"
+}
+
+// HandleEvent implements modules.MessageModule
+func (m *Module) HandleEvent(index int, event types.Event, tx *types.Tx) error {
+	return HandleEvent(event, m.db, m.flowClient)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return Register(scheduler, m.db, m.flowClient)
+}
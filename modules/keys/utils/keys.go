@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// KeyEvent represents a key added to or removed from an account through a
+// flow.AccountKeyAdded or flow.AccountKeyRemoved event
+type KeyEvent struct {
+	Address   string
+	PublicKey string
+	Removed   bool
+}
+
+// ParseKeyEvent returns the key change described by the given event.
+// It returns false if the given event is not a key event
+func ParseKeyEvent(event types.Event) (KeyEvent, bool, error) {
+	if event.Type != flow.EventAccountAdded && event.Type != flow.EventAccountKeyRemoved {
+		return KeyEvent{}, false, nil
+	}
+
+	addressValue, ok := event.Field("address")
+	address, isAddress := addressValue.(cadence.Address)
+	if !ok || !isAddress {
+		return KeyEvent{}, false, fmt.Errorf("invalid address of event %s: %s", event.Type, addressValue)
+	}
+
+	keyValue, ok := event.Field("publicKey")
+	keyArray, isArray := keyValue.(cadence.Array)
+	if !ok || !isArray {
+		return KeyEvent{}, false, fmt.Errorf("invalid public key of event %s: %s", event.Type, keyValue)
+	}
+
+	key := make([]byte, len(keyArray.Values))
+	for i, value := range keyArray.Values {
+		b, err := utils.CadenceConvertUint8(value)
+		if err != nil {
+			return KeyEvent{}, false, err
+		}
+		key[i] = b
+	}
+
+	return KeyEvent{
+		Address:   address.Hex(),
+		PublicKey: DecodeEventPublicKey(key),
+		Removed:   event.Type == flow.EventAccountKeyRemoved,
+	}, true, nil
+}
+
+// DecodeEventPublicKey returns the hex encoded public key contained inside a key event.
+// The AccountKeyAdded events contain the whole RLP encoded account key, while the
+// AccountKeyRemoved events contain only the public key
+func DecodeEventPublicKey(key []byte) string {
+	accountKey, err := flow.DecodeAccountKey(key)
+	if err != nil {
+		return hex.EncodeToString(key)
+	}
+	return hex.EncodeToString(accountKey.PublicKey.Encode())
+}
+
+// FindAccountKey returns the key of the given account that matches the given key event,
+// reading the account state at the given height. Keys whose change has already been recorded
+// through another event are never matched
+func FindAccountKey(event KeyEvent, height int64, recorded []int, client client.Proxy) (*flow.AccountKey, error) {
+	account, err := client.Client().GetAccountAtBlockHeight(client.Ctx(), flow.HexToAddress(event.Address), uint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account %s: %s", event.Address, err)
+	}
+
+	return MatchAccountKey(account.Keys, event, recorded), nil
+}
+
+// MatchAccountKey returns the key matching the given key event among the given ones, or nil if not found.
+// Since the same public key can be added more than once, the key with the highest index whose change
+// is not in the recorded ones is returned. If no key has the revoked status expected by the event,
+// which happens when a key is added and removed inside the same block, any key with the same public key is used
+func MatchAccountKey(keys []*flow.AccountKey, event KeyEvent, recorded []int) *flow.AccountKey {
+	skip := make(map[int]bool, len(recorded))
+	for _, index := range recorded {
+		skip[index] = true
+	}
+
+	var match, fallback *flow.AccountKey
+	for _, key := range keys {
+		if skip[key.Index] || hex.EncodeToString(key.PublicKey.Encode()) != event.PublicKey {
+			continue
+		}
+
+		if fallback == nil || key.Index > fallback.Index {
+			fallback = key
+		}
+
+		if key.Revoked == event.Removed && (match == nil || key.Index > match.Index) {
+			match = key
+		}
+	}
+
+	if match != nil {
+		return match
+	}
+	return fallback
+}
@@ -0,0 +1,103 @@
+package utils_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/keys/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+const pubkeyString = "d0d45a9f40dc5e7440c71fcbc1a7836e7b38cc7874ac2875c5475fe550f582e005a5ed864c779d413fe49f58d3451c24ccf2cc12b9495c2baeeb0752538a0bcb"
+
+func keyEvent(eventType string, key []byte) types.Event {
+	return testutil.NewEvent(10, eventType, 0,
+		testutil.AddressField("address", []byte{0x01}),
+		testutil.BytesField("publicKey", key),
+	)
+}
+
+func TestParseKeyEvent(t *testing.T) {
+	pubkey, err := crypto.DecodePublicKeyHex(crypto.ECDSA_P256, pubkeyString)
+	require.NoError(t, err)
+
+	accountKey := flow.NewAccountKey().SetWeight(1000).SetSigAlgo(crypto.ECDSA_P256).SetHashAlgo(crypto.SHA3_256).SetPublicKey(pubkey)
+
+	added, ok, err := utils.ParseKeyEvent(keyEvent(flow.EventAccountAdded, accountKey.Encode()))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, utils.KeyEvent{Address: "0000000000000001", PublicKey: pubkeyString}, added)
+
+	removed, ok, err := utils.ParseKeyEvent(keyEvent(flow.EventAccountKeyRemoved, pubkey.Encode()))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, utils.KeyEvent{Address: "0000000000000001", PublicKey: pubkeyString, Removed: true}, removed)
+
+	_, ok, err = utils.ParseKeyEvent(keyEvent(flow.EventAccountCreated, nil))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestMatchAccountKey(t *testing.T) {
+	pubkey, err := crypto.DecodePublicKeyHex(crypto.ECDSA_P256, pubkeyString)
+	require.NoError(t, err)
+
+	revoked := flow.NewAccountKey().SetPublicKey(pubkey)
+	revoked.Index = 0
+	revoked.Revoked = true
+
+	active := flow.NewAccountKey().SetPublicKey(pubkey)
+	active.Index = 1
+
+	keys := []*flow.AccountKey{revoked, active}
+	publicKey := hex.EncodeToString(pubkey.Encode())
+
+	require.Equal(t, active, utils.MatchAccountKey(keys, utils.KeyEvent{PublicKey: publicKey}, nil))
+	require.Equal(t, revoked, utils.MatchAccountKey(keys, utils.KeyEvent{PublicKey: publicKey, Removed: true}, nil))
+	require.Nil(t, utils.MatchAccountKey(keys, utils.KeyEvent{PublicKey: "abcd"}, nil))
+}
+
+func TestMatchAccountKey_Recorded(t *testing.T) {
+	pubkey, err := crypto.DecodePublicKeyHex(crypto.ECDSA_P256, pubkeyString)
+	require.NoError(t, err)
+
+	keys := make([]*flow.AccountKey, 3)
+	for i := range keys {
+		keys[i] = flow.NewAccountKey().SetPublicKey(pubkey)
+		keys[i].Index = i
+		keys[i].Revoked = true
+	}
+	publicKey := hex.EncodeToString(pubkey.Encode())
+	removed := utils.KeyEvent{PublicKey: publicKey, Removed: true}
+
+	// Keys whose revocation has already been recorded should not be matched again
+	require.Equal(t, keys[2], utils.MatchAccountKey(keys, removed, nil))
+	require.Equal(t, keys[1], utils.MatchAccountKey(keys, removed, []int{2}))
+	require.Equal(t, keys[0], utils.MatchAccountKey(keys, removed, []int{1, 2}))
+	require.Nil(t, utils.MatchAccountKey(keys, removed, []int{0, 1, 2}))
+}
+
+func TestMatchAccountKey_Fallback(t *testing.T) {
+	pubkey, err := crypto.DecodePublicKeyHex(crypto.ECDSA_P256, pubkeyString)
+	require.NoError(t, err)
+
+	// The key has been added and removed inside the same block
+	first := flow.NewAccountKey().SetPublicKey(pubkey)
+	first.Index = 0
+	first.Revoked = true
+
+	second := flow.NewAccountKey().SetPublicKey(pubkey)
+	second.Index = 1
+	second.Revoked = true
+
+	keys := []*flow.AccountKey{first, second}
+	added := utils.KeyEvent{PublicKey: hex.EncodeToString(pubkey.Encode())}
+
+	require.Equal(t, second, utils.MatchAccountKey(keys, added, nil))
+	require.Equal(t, first, utils.MatchAccountKey(keys, added, []int{1}))
+}
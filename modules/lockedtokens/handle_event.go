@@ -0,0 +1,88 @@
+package lockedtokens
+
+import (
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	lockedtokensutils "github.com/HarleyAppleChoi/junomum/modules/lockedtokens/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleEvent stores the given event if it has been emitted by the LockedTokens contract,
+// and refreshes the balance and unlock limit of the locked account it refers to at the event height
+func HandleEvent(event types.Event, tx *types.Tx, db *db.Db, flowClient client.Proxy) error {
+	lockedEvent, ok, err := lockedtokensutils.ParseLockedTokensEvent(event, flowClient.Contract().LockedTokens)
+	if err != nil || !ok {
+		return err
+	}
+
+	log.Debug().Str("module", "lockedtokens").Str("type", lockedEvent.Type).
+		Str("address", lockedEvent.Address).Msg("locked tokens event")
+
+	err = db.SaveLockedTokensEvents([]types.LockedTokensEvent{lockedEvent})
+	if err != nil {
+		return err
+	}
+
+	// The owner of a new shared account is refreshed when handling the UnlockedAccountRegistered event
+	if lockedEvent.Type == lockedtokensutils.SharedAccountRegisteredEvent {
+		return nil
+	}
+
+	owners, err := getOwnerCandidates(lockedEvent, tx, db)
+	if err != nil {
+		return err
+	}
+
+	return refreshLockedAccount(lockedEvent, owners, db, flowClient)
+}
+
+// getOwnerCandidates returns the addresses that could own the locked account referred by the given event.
+// When the owner is not known yet, the transaction authorizers are returned, since the owner
+// is the one signing the transactions that operate through the locked account
+func getOwnerCandidates(event types.LockedTokensEvent, tx *types.Tx, db *db.Db) ([]string, error) {
+	if event.Type == lockedtokensutils.UnlockedAccountRegisteredEvent {
+		return []string{flow.HexToAddress(event.Address).Hex()}, nil
+	}
+
+	owner, found, err := db.GetLockedAccountOwner(event.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		return []string{owner}, nil
+	}
+
+	return tx.Authorizers, nil
+}
+
+// refreshLockedAccount stores the balance and unlock limit of the locked account referred by the given event,
+// reading them from the first of the given candidates that owns it
+func refreshLockedAccount(event types.LockedTokensEvent, owners []string, db *db.Db, flowClient client.Proxy) error {
+	for _, owner := range owners {
+		balance, found, err := lockedtokensutils.GetLockedAccountBalance(owner, int64(event.Height), flowClient)
+		if err != nil {
+			return err
+		}
+
+		isOwner := found && (event.Type == lockedtokensutils.UnlockedAccountRegisteredEvent ||
+			balance.LockedAddress == event.Address)
+		if !isOwner {
+			continue
+		}
+
+		err = db.SaveLockedAccountOwners([]types.LockedAccount{types.NewLockedAccount(owner, balance.LockedAddress)})
+		if err != nil {
+			return err
+		}
+
+		return db.SaveLockedAccountBalance([]types.LockedAccountBalance{balance})
+	}
+
+	log.Debug().Str("module", "lockedtokens").Str("address", event.Address).
+		Msg("owner of locked account not found")
+	return nil
+}
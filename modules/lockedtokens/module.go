@@ -0,0 +1,37 @@
+package lockedtokens
+
+import (
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module        = &Module{}
+	_ modules.MessageModule = &Module{}
+)
+
+// Module represents the module that follows the LockedTokens contract events
+type Module struct {
+	flowClient client.Proxy
+	db         *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		flowClient: flowClient,
+		db:         db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "lockedtokens"
+}
+
+// HandleEvent implements modules.MessageModule
+func (m *Module) HandleEvent(index int, event types.Event, tx *types.Tx) error {
+	return HandleEvent(event, tx, m.db, m.flowClient)
+}
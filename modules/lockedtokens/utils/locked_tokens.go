@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+const (
+	UnlockedAccountRegisteredEvent = "UnlockedAccountRegistered"
+	SharedAccountRegisteredEvent   = "SharedAccountRegistered"
+)
+
+// ParseLockedTokensEvent returns the locked tokens event represented by the given event, if it has been emitted
+// by the LockedTokens contract deployed at the given address. Otherwise, false is returned
+func ParseLockedTokensEvent(event types.Event, lockedTokens string) (types.LockedTokensEvent, bool, error) {
+	prefix := fmt.Sprintf("A.%s.LockedTokens.", strings.TrimPrefix(lockedTokens, "0x"))
+	if !strings.HasPrefix(event.Type, prefix) {
+		return types.LockedTokensEvent{}, false, nil
+	}
+
+	var address string
+	if value, ok := event.Field("address"); ok {
+		cadenceAddress, ok := value.(cadence.Address)
+		if !ok {
+			return types.LockedTokensEvent{}, false, fmt.Errorf("%s event address is not an address: %s", event.Type, value)
+		}
+		address = cadenceAddress.String()
+	}
+
+	var nodeID string
+	if value, ok := event.Field("nodeID"); ok {
+		id, err := utils.CadanceConvertString(value)
+		if err != nil {
+			return types.LockedTokensEvent{}, false, err
+		}
+		nodeID = id
+	}
+
+	var amount *uint64
+	for _, field := range []string{"amount", "increaseAmount"} {
+		if value, ok := event.Field(field); ok {
+			tokens, err := utils.CadenceConvertUint64(value)
+			if err != nil {
+				return types.LockedTokensEvent{}, false, err
+			}
+			amount = &tokens
+			break
+		}
+	}
+
+	var newLimit *uint64
+	if value, ok := event.Field("newLimit"); ok {
+		limit, err := utils.CadenceConvertUint64(value)
+		if err != nil {
+			return types.LockedTokensEvent{}, false, err
+		}
+		newLimit = &limit
+	}
+
+	return types.NewLockedTokensEvent(event.TransactionID, event.EventIndex, uint64(event.Height), event.Name(),
+		address, nodeID, amount, newLimit), true, nil
+}
+
+// GetLockedAccountBalance returns the locked account address, balance and unlock limit of the given account at
+// the given height. It returns false if the account does not own any locked account at that height
+func GetLockedAccountBalance(address string, height int64, flowClient client.Proxy) (types.LockedAccountBalance, bool, error) {
+	script := fmt.Sprintf(`
+	import LockedTokens from %s
+
+	pub fun main(account: Address): [AnyStruct]? {
+		let lockedAccountInfoRef = getAccount(account)
+			.getCapability<&LockedTokens.TokenHolder{LockedTokens.LockedAccountInfo}>(
+				LockedTokens.LockedAccountInfoPublicPath
+			)
+			.borrow()
+		if lockedAccountInfoRef == nil {
+			return nil
+		}
+
+		return [
+			lockedAccountInfoRef!.getLockedAccountAddress(),
+			lockedAccountInfoRef!.getLockedAccountBalance(),
+			lockedAccountInfoRef!.getUnlockLimit()
+		]
+	}`, flowClient.Contract().LockedTokens)
+
+	value, err := flowClient.Client().ExecuteScriptAtBlockHeight(flowClient.Ctx(), uint64(height), []byte(script),
+		[]cadence.Value{cadence.Address(flow.HexToAddress(address))})
+	if err != nil {
+		return types.LockedAccountBalance{}, false, fmt.Errorf("error while getting locked account of %s: %s", address, err)
+	}
+
+	if optional, ok := value.(cadence.Optional); ok {
+		value = optional.Value
+	}
+
+	if value == nil {
+		return types.LockedAccountBalance{}, false, nil
+	}
+
+	balance, err := NewLockedAccountBalanceFromCadence(value, uint64(height))
+	return balance, err == nil, err
+}
+
+// NewLockedAccountBalanceFromCadence builds a LockedAccountBalance from the array returned by the locked account script
+func NewLockedAccountBalanceFromCadence(value cadence.Value, height uint64) (types.LockedAccountBalance, error) {
+	array, ok := value.(cadence.Array)
+	if !ok || len(array.Values) != 3 {
+		return types.LockedAccountBalance{}, fmt.Errorf("invalid locked account value: %s", value)
+	}
+
+	lockedAddress, ok := array.Values[0].(cadence.Address)
+	if !ok {
+		return types.LockedAccountBalance{}, fmt.Errorf("locked account address is not an address: %s", array.Values[0])
+	}
+
+	balance, err := utils.CadenceConvertUint64(array.Values[1])
+	if err != nil {
+		return types.LockedAccountBalance{}, err
+	}
+
+	unlockLimit, err := utils.CadenceConvertUint64(array.Values[2])
+	if err != nil {
+		return types.LockedAccountBalance{}, err
+	}
+
+	return types.NewLockedAccountBalance(lockedAddress.String(), balance, unlockLimit, height), nil
+}
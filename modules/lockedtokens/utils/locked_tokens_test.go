@@ -0,0 +1,62 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/lockedtokens/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+const lockedTokens = "0x8d0e87b65159ae63"
+
+func TestParseLockedTokensEvent(t *testing.T) {
+	lockedAddress := []byte{0x2}
+
+	event := testutil.NewEvent(10, "A.8d0e87b65159ae63.LockedTokens.UnlockLimitIncreased", 1,
+		testutil.AddressField("address", lockedAddress),
+		testutil.UFix64Field("increaseAmount", 100000000),
+		testutil.UFix64Field("newLimit", 300000000),
+	)
+
+	lockedEvent, ok, err := utils.ParseLockedTokensEvent(event, lockedTokens)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	increase, limit := uint64(100000000), uint64(300000000)
+	require.True(t, lockedEvent.Equal(types.NewLockedTokensEvent("0x6", 1, 10, "UnlockLimitIncreased",
+		"0x2", "", &increase, &limit)))
+
+	event = testutil.NewEvent(10, "A.8d0e87b65159ae63.LockedTokens.LockedAccountRegisteredAsNode", 1,
+		testutil.AddressField("address", lockedAddress),
+		testutil.StringField("nodeID", "node-1"),
+	)
+
+	lockedEvent, ok, err = utils.ParseLockedTokensEvent(event, lockedTokens)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, lockedEvent.Equal(types.NewLockedTokensEvent("0x6", 1, 10, "LockedAccountRegisteredAsNode",
+		"0x2", "node-1", nil, nil)))
+
+	// Events emitted by other contracts are ignored
+	event = testutil.NewEvent(10, "A.1654653399040a61.FlowToken.TokensDeposited", 1)
+	_, ok, err = utils.ParseLockedTokensEvent(event, lockedTokens)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestNewLockedAccountBalanceFromCadence(t *testing.T) {
+	value := cadence.NewArray([]cadence.Value{
+		cadence.BytesToAddress([]byte{0x2}), cadence.UFix64(500000000), cadence.UFix64(100000000),
+	})
+
+	balance, err := utils.NewLockedAccountBalanceFromCadence(value, 10)
+	require.NoError(t, err)
+	require.Equal(t, types.NewLockedAccountBalance("0x2", 500000000, 100000000, 10), balance)
+
+	_, err = utils.NewLockedAccountBalanceFromCadence(cadence.NewArray(nil), 10)
+	require.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/HarleyAppleChoi/junomum/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/onflow/cadence"
 	/*	sdk "github.com/cosmos/cosmos-sdk/types"
 		banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 		distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -22,16 +23,16 @@ func MessageNotSupported(tx types.Tx) error {
 	return fmt.Errorf("message type not supported: %s", tx.Script)
 }
 
-// MessageAddressesParser represents a function that extracts all the
-// involved addresses from a provided message (both accounts and validators)
-type MessageAddressesParser = func(cdc codec.Marshaler, tx types.Tx) ([]string, error)
+// MessageAddressesParser represents a function that extracts all the involved addresses from a
+// provided transaction, using the events it emitted and its decoded arguments as well
+type MessageAddressesParser = func(cdc codec.Marshaler, tx types.Tx, events []types.Event, arguments []cadence.Value) ([]string, error)
 
 // JoinMessageParsers joins together all the given parsers, calling them in order
 func JoinMessageParsers(parsers ...MessageAddressesParser) MessageAddressesParser {
-	return func(cdc codec.Marshaler, tx types.Tx) ([]string, error) {
+	return func(cdc codec.Marshaler, tx types.Tx, events []types.Event, arguments []cadence.Value) ([]string, error) {
 		for _, parser := range parsers {
 			// Try getting the addresses
-			addresses, _ := parser(cdc, tx)
+			addresses, _ := parser(cdc, tx, events, arguments)
 
 			// If some addresses are found, return them
 			if len(addresses) > 0 {
@@ -42,6 +43,30 @@ func JoinMessageParsers(parsers ...MessageAddressesParser) MessageAddressesParse
 	}
 }
 
+// MergeMessageParsers merges together all the given parsers, returning the union of the
+// addresses found by each one of them without duplicates
+func MergeMessageParsers(parsers ...MessageAddressesParser) MessageAddressesParser {
+	return func(cdc codec.Marshaler, tx types.Tx, events []types.Event, arguments []cadence.Value) ([]string, error) {
+		var addresses []string
+		found := make(map[string]bool)
+		for _, parser := range parsers {
+			parsed, err := parser(cdc, tx, events, arguments)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, address := range parsed {
+				if address == "" || found[address] {
+					continue
+				}
+				found[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+		return addresses, nil
+	}
+}
+
 // CosmosMessageAddressesParser represents a MessageAddressesParser that parses a
 // Cosmos message and returns all the involved addresses (both accounts and validators)
 var CosmosMessageAddressesParser = JoinMessageParsers(
@@ -56,9 +81,18 @@ var CosmosMessageAddressesParser = JoinMessageParsers(
 	DefaultMessagesParser,
 )
 
+// FlowMessageAddressesParser represents a MessageAddressesParser that returns all the accounts
+// that sign a transaction, that send or receive tokens and NFTs, or that are passed as arguments
+var FlowMessageAddressesParser = MergeMessageParsers(
+	DefaultMessagesParser,
+	TokensEventsParser,
+	NFTEventsParser,
+	AddressArgumentsParser,
+)
+
 // DefaultMessagesParser represents the default messages parser that simply returns all account that
 // mutate the state by the transaction
-func DefaultMessagesParser(_ codec.Marshaler, tx types.Tx) ([]string, error) {
+func DefaultMessagesParser(_ codec.Marshaler, tx types.Tx, _ []types.Event, _ []cadence.Value) ([]string, error) {
 	var signers []string
 	for _, authorizers := range tx.Authorizers {
 		signers = append(signers, authorizers)
@@ -66,6 +100,53 @@ func DefaultMessagesParser(_ codec.Marshaler, tx types.Tx) ([]string, error) {
 	return signers, nil
 }
 
+// TokensEventsParser returns the accounts that sent or received fungible tokens,
+// reading the TokensWithdrawn and TokensDeposited events
+func TokensEventsParser(_ codec.Marshaler, _ types.Tx, events []types.Event, _ []cadence.Value) ([]string, error) {
+	return eventsAddresses(events, map[string]string{
+		"TokensWithdrawn": "from",
+		"TokensDeposited": "to",
+	}), nil
+}
+
+// NFTEventsParser returns the accounts that sent or received non fungible tokens,
+// reading the Withdraw and Deposit events
+func NFTEventsParser(_ codec.Marshaler, _ types.Tx, events []types.Event, _ []cadence.Value) ([]string, error) {
+	return eventsAddresses(events, map[string]string{
+		"Withdraw": "from",
+		"Deposit":  "to",
+	}), nil
+}
+
+// AddressArgumentsParser returns all the addresses passed as arguments of the transaction
+func AddressArgumentsParser(_ codec.Marshaler, _ types.Tx, _ []types.Event, arguments []cadence.Value) ([]string, error) {
+	var addresses []string
+	for _, argument := range arguments {
+		addresses = append(addresses, types.CadenceAddresses(argument)...)
+	}
+	return addresses, nil
+}
+
+// eventsAddresses returns the addresses contained inside the given events.
+// fields maps the name of each supported event to the name of the field containing the address
+func eventsAddresses(events []types.Event, fields map[string]string) []string {
+	var addresses []string
+	for _, event := range events {
+		field, ok := fields[event.Name()]
+		if !ok {
+			continue
+		}
+
+		value, ok := event.Field(field)
+		if !ok {
+			continue
+		}
+
+		addresses = append(addresses, types.CadenceAddresses(value)...)
+	}
+	return addresses
+}
+
 /*
 // BankMessagesParser returns the list of all the accounts involved in the given
 // message if it's related to the x/bank module
@@ -0,0 +1,58 @@
+package messages_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func TestFlowMessageAddressesParser(t *testing.T) {
+	tokensType := &cadence.EventType{
+		QualifiedIdentifier: "FlowToken.TokensDeposited",
+		Fields: []cadence.Field{
+			{Identifier: "amount", Type: cadence.UFix64Type{}},
+			{Identifier: "to", Type: cadence.OptionalType{Type: cadence.AddressType{}}},
+		},
+	}
+	nftType := &cadence.EventType{
+		QualifiedIdentifier: "TopShot.Withdraw",
+		Fields: []cadence.Field{
+			{Identifier: "id", Type: cadence.UInt64Type{}},
+			{Identifier: "from", Type: cadence.OptionalType{Type: cadence.AddressType{}}},
+		},
+	}
+
+	events := []types.Event{
+		types.NewEvent(10, "A.1654653399040a61.FlowToken.TokensDeposited", "0x6", 0, 0,
+			cadence.NewEvent([]cadence.Value{
+				cadence.UFix64(100),
+				cadence.NewOptional(cadence.BytesToAddress([]byte{0x16, 0x54, 0x65, 0x33, 0x99, 0x04, 0x0a, 0x61})),
+			}).WithType(tokensType)),
+		types.NewEvent(10, "A.0b2a3299cc857e29.TopShot.Withdraw", "0x6", 0, 1,
+			cadence.NewEvent([]cadence.Value{
+				cadence.UInt64(1),
+				cadence.NewOptional(cadence.BytesToAddress([]byte{0x0b, 0x2a, 0x32, 0x99, 0xcc, 0x85, 0x7e, 0x29})),
+			}).WithType(nftType)),
+	}
+
+	arguments := []cadence.Value{
+		cadence.UFix64(100),
+		cadence.BytesToAddress([]byte{0xf2, 0x33, 0xdc, 0xee, 0x88, 0xfe, 0x0a, 0xbe}),
+	}
+
+	tx := types.NewTx(10, "0x6", nil, nil, "0x2", 100,
+		"8d0e87b65159ae63", "8d0e87b65159ae63", []string{"8d0e87b65159ae63"}, nil, nil)
+
+	addresses, err := messages.FlowMessageAddressesParser(nil, tx, events, arguments)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"8d0e87b65159ae63",
+		"1654653399040a61",
+		"0b2a3299cc857e29",
+		"f233dcee88fe0abe",
+	}, addresses)
+}
@@ -77,6 +77,27 @@ type BlockModule interface {
 	HandleBlock(block *flow.Block, txs *types.Txs) error
 }
 
+type ParsedBlockModule interface {
+	// HandleParsedBlock allows to handle a single block once all of its transactions and events have been stored.
+	// Unlike HandleBlock, the given transactions contain their events as well.
+	// NOTE. The returned error will be logged using the logging.LogBlockError method. All other modules' handlers
+	// will still be called.
+	HandleParsedBlock(block *flow.Block, txs types.Txs) error
+}
+
+type SystemEventsModule interface {
+	// SystemEventTypes returns the types of the events the module should be given.
+	SystemEventTypes() []string
+
+	// HandleSystemEvents handles the events having one of the types returned by SystemEventTypes that have been
+	// emitted inside the given block, sorted by the order in which they have been emitted.
+	// Unlike HandleEvent, the events emitted by the system chunk transaction, which is not part of any collection,
+	// are included as well. The events of all the modules are fetched once per block, after it has been stored.
+	// NOTE. The returned error will be logged using the logging.LogBlockError method. All other modules' handlers
+	// will still be called.
+	HandleSystemEvents(block *flow.Block, events []types.Event) error
+}
+
 type TransactionModule interface {
 	// HandleTx handles a single transaction.
 	// For each message present inside the transaction, HandleEvent will be called as well.
@@ -93,3 +114,10 @@ type MessageModule interface {
 	// will still be called.
 	HandleEvent(index int, msg types.Event, tx *types.Tx) error
 }
+
+type BackfillModule interface {
+	// Backfill rebuilds the module data using what has already been stored inside the database
+	// (eg. the events table), without querying the chain again.
+	// NOTE. This method is only run by the backfill command, and it should be safe to run it multiple times.
+	Backfill() error
+}
@@ -0,0 +1,71 @@
+package nft
+
+import (
+	"github.com/rs/zerolog/log"
+
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	nftutils "github.com/HarleyAppleChoi/junomum/modules/nft/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// backfillBatchSize represents the number of blocks whose events are read at once during the backfill
+const backfillBatchSize = 1000
+
+// Backfill rebuilds the non fungible token transfers and owners using the Withdraw and Deposit
+// events already stored inside the database
+func Backfill(db *db.Db) error {
+	firstHeight, err := db.GetFirstBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	lastHeight, err := db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	storedTypes, err := db.GetEventTypes()
+	if err != nil {
+		return err
+	}
+
+	eventTypes := nftutils.GetTransferEventTypes(storedTypes)
+	if len(eventTypes) == 0 {
+		return nil
+	}
+
+	for from := firstHeight; from <= lastHeight; from += backfillBatchSize {
+		to := from + backfillBatchSize - 1
+		if to > lastHeight {
+			to = lastHeight
+		}
+
+		log.Debug().Str("module", "nft").Int64("from", from).Int64("to", to).Msg("backfilling transfers")
+
+		events, err := db.GetEventsByType(eventTypes, from, to)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range groupByTransaction(events) {
+			err = saveTransfers(db, nftutils.GetTransfers(tx))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupByTransaction groups the given sorted events into the transactions that emitted them
+func groupByTransaction(events []types.Event) []types.Tx {
+	var txs []types.Tx
+	for _, event := range events {
+		if len(txs) == 0 || txs[len(txs)-1].TransactionID != event.TransactionID {
+			txs = append(txs, types.Tx{TransactionID: event.TransactionID, Height: uint64(event.Height)})
+		}
+		txs[len(txs)-1].Events = append(txs[len(txs)-1].Events, event)
+	}
+	return txs
+}
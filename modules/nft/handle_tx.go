@@ -0,0 +1,23 @@
+package nft
+
+import (
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	nftutils "github.com/HarleyAppleChoi/junomum/modules/nft/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleTx stores the non fungible token transfers performed by the given transaction,
+// updating the owners of the moved tokens
+func HandleTx(db *db.Db, tx *types.Tx) error {
+	return saveTransfers(db, nftutils.GetTransfers(*tx))
+}
+
+// saveTransfers stores the given transfers along with the owners they result in
+func saveTransfers(db *db.Db, transfers []types.NFTTransfer) error {
+	err := db.SaveNFTTransfers(transfers)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveNFTOwners(nftutils.GetOwners(transfers))
+}
@@ -0,0 +1,54 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+	_ modules.BackfillModule    = &Module{}
+)
+
+// Module represents the module that keeps track of the owners of the non fungible tokens
+type Module struct {
+	messagesParser messages.MessageAddressesParser
+	encodingConfig *params.EncodingConfig
+	flowClient     client.Proxy
+	db             *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(
+	messagesParser messages.MessageAddressesParser,
+	flowClient client.Proxy,
+	encodingConfig *params.EncodingConfig, db *db.Db,
+) *Module {
+	return &Module{
+		messagesParser: messagesParser,
+		encodingConfig: encodingConfig,
+		flowClient:     flowClient,
+		db:             db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "nft"
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(index int, tx *types.Tx) error {
+	return HandleTx(m.db, tx)
+}
+
+// Backfill implements modules.BackfillModule
+func (m *Module) Backfill() error {
+	return Backfill(m.db)
+}
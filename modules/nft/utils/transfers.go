@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+const (
+	WithdrawEvent = "Withdraw"
+	DepositEvent  = "Deposit"
+)
+
+// GetTransferEventTypes returns the types among the given ones of the Withdraw and Deposit events
+// emitted by any contract
+func GetTransferEventTypes(eventTypes []string) []string {
+	var transferTypes []string
+	for _, eventType := range eventTypes {
+		if strings.HasSuffix(eventType, "."+WithdrawEvent) || strings.HasSuffix(eventType, "."+DepositEvent) {
+			transferTypes = append(transferTypes, eventType)
+		}
+	}
+	return transferTypes
+}
+
+// movement represents the token moved by a single Withdraw or Deposit event
+type movement struct {
+	contract string
+	tokenID  uint64
+	address  string
+}
+
+// GetTransfers pairs the Withdraw and Deposit events emitted by the given transaction by any contract
+// implementing the NonFungibleToken interface into transfers.
+//
+// Each deposit is paired with the pending withdrawal of the same token, if any, otherwise the token
+// is considered as minted. Withdrawals that are never deposited are returned as transfers without a recipient.
+func GetTransfers(tx types.Tx) []types.NFTTransfer {
+	var transfers []types.NFTTransfer
+	add := func(contract string, tokenID uint64, from string, to string) {
+		transfers = append(transfers, types.NewNFTTransfer(
+			tx.TransactionID, tx.Height, len(transfers), contract, tokenID, from, to,
+		))
+	}
+
+	var pending []*movement
+	for _, event := range tx.Events {
+		switch event.Name() {
+		case WithdrawEvent:
+			withdrawal, ok := getMovement(event, "from")
+			if ok {
+				pending = append(pending, withdrawal)
+			}
+
+		case DepositEvent:
+			deposit, ok := getMovement(event, "to")
+			if !ok {
+				continue
+			}
+
+			if i := findToken(pending, deposit.contract, deposit.tokenID); i >= 0 {
+				add(deposit.contract, deposit.tokenID, pending[i].address, deposit.address)
+				pending = append(pending[:i], pending[i+1:]...)
+				continue
+			}
+
+			add(deposit.contract, deposit.tokenID, "", deposit.address)
+		}
+	}
+
+	for _, withdrawal := range pending {
+		add(withdrawal.contract, withdrawal.tokenID, withdrawal.address, "")
+	}
+
+	return transfers
+}
+
+// GetOwners returns the owner of each token moved by the given transfers after all of them have been performed
+func GetOwners(transfers []types.NFTTransfer) []types.NFTOwner {
+	var owners []types.NFTOwner
+	indexes := make(map[movement]int)
+	for _, transfer := range transfers {
+		key := movement{contract: transfer.Contract, tokenID: transfer.TokenID}
+		owner := types.NewNFTOwner(transfer.Contract, transfer.TokenID, transfer.To, transfer.Height)
+
+		if i, found := indexes[key]; found {
+			owners[i] = owner
+			continue
+		}
+
+		indexes[key] = len(owners)
+		owners = append(owners, owner)
+	}
+	return owners
+}
+
+// getMovement reads the token id and the address stored inside the given field of the event.
+// It returns false if the event does not have the fields of the NonFungibleToken events, since
+// contracts that do not implement NonFungibleToken can emit events having the same names
+func getMovement(event types.Event, addressField string) (*movement, bool) {
+	id, ok := event.Field("id")
+	if !ok {
+		return nil, false
+	}
+
+	address, ok := event.Field(addressField)
+	if !ok {
+		return nil, false
+	}
+
+	tokenID, err := utils.CadenceConvertUint64(id)
+	if err != nil {
+		return nil, false
+	}
+
+	m := movement{
+		contract: strings.TrimSuffix(event.Type, "."+event.Name()),
+		tokenID:  tokenID,
+	}
+
+	if optional, isOptional := address.(cadence.Optional); isOptional {
+		address = optional.Value
+	}
+	if value, isAddress := address.(cadence.Address); isAddress {
+		m.address = value.Hex()
+	}
+
+	return &m, true
+}
+
+// findToken returns the index of the movement of the given token, or -1 if not found
+func findToken(movements []*movement, contract string, tokenID uint64) int {
+	for i, m := range movements {
+		if m.contract == contract && m.tokenID == tokenID {
+			return i
+		}
+	}
+	return -1
+}
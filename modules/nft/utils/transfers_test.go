@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/nft/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+const topShot = "A.0b2a3299cc857e29.TopShot"
+
+func nftEvent(name string, addressField string, id cadence.Value, address []byte) types.Event {
+	return testutil.NewEvent(10, topShot+"."+name, 0,
+		testutil.NewEventField("id", cadence.UInt64Type{}, id),
+		testutil.OptionalAddressField(addressField, address),
+	)
+}
+
+func TestGetTransferEventTypes(t *testing.T) {
+	eventTypes := utils.GetTransferEventTypes([]string{
+		"A.0b2a3299cc857e29.TopShot.Deposit",
+		"A.0b2a3299cc857e29.TopShot.MomentMinted",
+		"A.1654653399040a61.FlowToken.TokensDeposited",
+		"A.921ea449dffec68a.Flovatar.Withdraw",
+		"flow.AccountCreated",
+	})
+	require.Equal(t, []string{"A.0b2a3299cc857e29.TopShot.Deposit", "A.921ea449dffec68a.Flovatar.Withdraw"}, eventTypes)
+}
+
+func TestGetTransfers(t *testing.T) {
+	alice := []byte{0x01}
+	bob := []byte{0x02}
+
+	tx := types.NewTx(10, "0x6", nil, nil, "0x2", 100, "", "", nil, nil, nil)
+	tx.Events = []types.Event{
+		// Alice sends token 1 to Bob
+		nftEvent("Withdraw", "from", cadence.UInt64(1), alice),
+		nftEvent("Deposit", "to", cadence.UInt64(1), bob),
+
+		// Token 2 is minted to Alice
+		nftEvent("Deposit", "to", cadence.UInt64(2), alice),
+
+		// Token 3 is burned by Bob
+		nftEvent("Withdraw", "from", cadence.UInt64(3), bob),
+
+		// Events with the same name not emitted by a NonFungibleToken contract
+		nftEvent("Deposit", "to", cadence.String("not-an-id"), bob),
+	}
+
+	transfers := utils.GetTransfers(tx)
+	require.Equal(t, []types.NFTTransfer{
+		types.NewNFTTransfer("0x6", 10, 0, topShot, 1, "0000000000000001", "0000000000000002"),
+		types.NewNFTTransfer("0x6", 10, 1, topShot, 2, "", "0000000000000001"),
+		types.NewNFTTransfer("0x6", 10, 2, topShot, 3, "0000000000000002", ""),
+	}, transfers)
+}
+
+func TestGetOwners(t *testing.T) {
+	transfers := []types.NFTTransfer{
+		types.NewNFTTransfer("0x6", 10, 0, topShot, 1, "", "0000000000000001"),
+		types.NewNFTTransfer("0x6", 10, 1, topShot, 2, "", "0000000000000001"),
+		types.NewNFTTransfer("0x7", 11, 0, topShot, 1, "0000000000000001", "0000000000000002"),
+	}
+
+	require.Equal(t, []types.NFTOwner{
+		types.NewNFTOwner(topShot, 1, "0000000000000002", 11),
+		types.NewNFTOwner(topShot, 2, "0000000000000001", 10),
+	}, utils.GetOwners(transfers))
+}
@@ -0,0 +1,51 @@
+package nftmetadata
+
+import (
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// Register registers the utils that should be run periodically
+func Register(
+	scheduler *gocron.Scheduler, cfg *config.NFTConfig, resolver *Resolver, db *database.Db, flowClient client.Proxy,
+) error {
+	log.Debug().Str("module", "nftmetadata").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(10).Minutes().Do(func() {
+		utils.WatchMethod(func() error { return retryFailedMetadata(cfg, resolver, db, flowClient) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// retryFailedMetadata resolves again, at the latest height, the metadata of the tokens
+// whose resolution failed less than the configured number of times
+func retryFailedMetadata(cfg *config.NFTConfig, resolver *Resolver, db *database.Db, flowClient client.Proxy) error {
+	tokens, err := db.GetFailedNFTMetadata(cfg.GetMetadataRetries())
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	height, err := flowClient.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	log.Debug().Str("module", "nftmetadata").Int("tokens", len(tokens)).Msg("retrying failed metadata")
+	for i := range tokens {
+		tokens[i].Height = uint64(height)
+	}
+
+	return db.SaveNFTMetadata(resolver.Resolve(tokens))
+}
@@ -0,0 +1,34 @@
+package nftmetadata
+
+import (
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	nftutils "github.com/HarleyAppleChoi/junomum/modules/nft/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleTx resolves and stores the metadata of the tokens of the tracked collections
+// that are seen for the first time inside the given transaction
+func HandleTx(resolver *Resolver, db *db.Db, tx *types.Tx) error {
+	var tokens []types.NFTMetadata
+	for _, owner := range nftutils.GetOwners(nftutils.GetTransfers(*tx)) {
+		if owner.Owner == "" || !resolver.IsTracked(owner.Contract) {
+			continue
+		}
+
+		found, err := db.HasNFTMetadata(owner.Contract, owner.TokenID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			tokens = append(tokens, types.NFTMetadata{
+				Contract: owner.Contract,
+				TokenID:  owner.TokenID,
+				Owner:    owner.Owner,
+				Height:   owner.Height,
+			})
+		}
+	}
+
+	return db.SaveNFTMetadata(resolver.Resolve(tokens))
+}
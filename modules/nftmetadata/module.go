@@ -0,0 +1,51 @@
+package nftmetadata
+
+import (
+	"github.com/go-co-op/gocron"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.TransactionModule        = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that resolves the metadata of the non fungible tokens
+// belonging to the configured collections
+type Module struct {
+	cfg        *config.NFTConfig
+	flowClient client.Proxy
+	db         *db.Db
+	resolver   *Resolver
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg *config.NFTConfig, flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		cfg:        cfg,
+		flowClient: flowClient,
+		db:         db,
+		resolver:   NewResolver(cfg, flowClient),
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "nftmetadata"
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(index int, tx *types.Tx) error {
+	return HandleTx(m.resolver, m.db, tx)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return Register(scheduler, m.cfg, m.resolver, m.db, m.flowClient)
+}
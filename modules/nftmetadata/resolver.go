@@ -0,0 +1,92 @@
+package nftmetadata
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	nftmetadatautils "github.com/HarleyAppleChoi/junomum/modules/nftmetadata/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// metadataGetter resolves the metadata of the given token held by the given owner at the given height
+type metadataGetter func(
+	collection config.NFTCollectionConfig, tokenID uint64, owner string, height int64,
+) (types.NFTMetadata, error)
+
+// Resolver resolves the metadata of the tokens of the configured collections,
+// limiting the number of scripts that are executed at the same time across all the parsing workers
+type Resolver struct {
+	collections map[string]config.NFTCollectionConfig
+	getMetadata metadataGetter
+	workers     chan struct{}
+}
+
+// NewResolver builds a new Resolver instance
+func NewResolver(cfg *config.NFTConfig, flowClient client.Proxy) *Resolver {
+	return newResolver(cfg, func(
+		collection config.NFTCollectionConfig, tokenID uint64, owner string, height int64,
+	) (types.NFTMetadata, error) {
+		return nftmetadatautils.GetNFTMetadata(collection, tokenID, owner, height, flowClient)
+	})
+}
+
+// newResolver builds a new Resolver instance that uses the given getter to resolve the metadata
+func newResolver(cfg *config.NFTConfig, getMetadata metadataGetter) *Resolver {
+	collections := make(map[string]config.NFTCollectionConfig, len(cfg.GetCollections()))
+	for _, collection := range cfg.GetCollections() {
+		collections[collection.GetIdentifier()] = collection
+	}
+
+	return &Resolver{
+		collections: collections,
+		getMetadata: getMetadata,
+		workers:     make(chan struct{}, cfg.GetMetadataWorkers()),
+	}
+}
+
+// IsTracked tells whether the metadata of the tokens of the given contract should be resolved
+func (r *Resolver) IsTracked(contract string) bool {
+	_, found := r.collections[contract]
+	return found
+}
+
+// Resolve resolves the metadata of the given tokens, using their owners and heights.
+// Tokens whose resolution fails are returned as failed metadata, so that they can be retried later.
+// The attempts of each token are increased by one
+func (r *Resolver) Resolve(tokens []types.NFTMetadata) []types.NFTMetadata {
+	resolved := make([]types.NFTMetadata, len(tokens))
+
+	var wg sync.WaitGroup
+	for i, token := range tokens {
+		wg.Add(1)
+		go func(i int, token types.NFTMetadata) {
+			defer wg.Done()
+
+			r.workers <- struct{}{}
+			defer func() { <-r.workers }()
+
+			resolved[i] = r.resolve(token)
+		}(i, token)
+	}
+	wg.Wait()
+
+	return resolved
+}
+
+// resolve resolves the metadata of the given token
+func (r *Resolver) resolve(token types.NFTMetadata) types.NFTMetadata {
+	attempts := token.Attempts + 1
+
+	metadata, err := r.getMetadata(r.collections[token.Contract], token.TokenID, token.Owner, int64(token.Height))
+	if err != nil {
+		log.Debug().Str("module", "nftmetadata").Str("contract", token.Contract).Uint64("token_id", token.TokenID).
+			Int("attempts", attempts).Err(err).Msg("error while resolving metadata")
+		return types.NewFailedNFTMetadata(token.Contract, token.TokenID, token.Owner, token.Height, attempts, err)
+	}
+
+	metadata.Attempts = attempts
+	return metadata
+}
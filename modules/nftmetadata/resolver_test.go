@@ -0,0 +1,101 @@
+package nftmetadata
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+var topShot = config.NewNFTCollectionConfig("TopShot", "0x0b2a3299cc857e29", "/public/MomentCollection")
+
+func TestResolver_IsTracked(t *testing.T) {
+	resolver := newResolver(config.NewNFTConfig([]config.NFTCollectionConfig{topShot}, 1, 3), nil)
+
+	require.True(t, resolver.IsTracked("A.0b2a3299cc857e29.TopShot"))
+	require.False(t, resolver.IsTracked("A.1d7e57aa55817448.NonFungibleToken"))
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	cfg := config.NewNFTConfig([]config.NFTCollectionConfig{topShot}, 2, 3)
+	resolver := newResolver(cfg, func(
+		collection config.NFTCollectionConfig, tokenID uint64, owner string, height int64,
+	) (types.NFTMetadata, error) {
+		if tokenID%2 == 0 {
+			return types.NFTMetadata{}, fmt.Errorf("cannot borrow")
+		}
+		return types.NewNFTMetadata(collection.GetIdentifier(), tokenID, owner, "Moment", "", "", "", uint64(height), 0), nil
+	})
+
+	contract := topShot.GetIdentifier()
+	resolved := resolver.Resolve([]types.NFTMetadata{
+		{Contract: contract, TokenID: 1, Owner: "0000000000000001", Height: 10},
+		{Contract: contract, TokenID: 2, Owner: "0000000000000001", Height: 10, Attempts: 1},
+	})
+
+	// Tokens should be returned in order, with their attempts increased by one
+	require.Equal(t, []types.NFTMetadata{
+		types.NewNFTMetadata(contract, 1, "0000000000000001", "Moment", "", "", "", 10, 1),
+		types.NewFailedNFTMetadata(contract, 2, "0000000000000001", 10, 2, fmt.Errorf("cannot borrow")),
+	}, resolved)
+}
+
+func TestResolver_Resolve_Retries(t *testing.T) {
+	cfg := config.NewNFTConfig([]config.NFTCollectionConfig{topShot}, 1, 3)
+	resolver := newResolver(cfg, func(
+		collection config.NFTCollectionConfig, tokenID uint64, owner string, height int64,
+	) (types.NFTMetadata, error) {
+		return types.NFTMetadata{}, fmt.Errorf("cannot borrow")
+	})
+
+	// Each failed resolution should count as an attempt, until the configured retries are reached
+	tokens := []types.NFTMetadata{{Contract: topShot.GetIdentifier(), TokenID: 1, Owner: "0000000000000001", Height: 10}}
+	for i := 1; i <= cfg.GetMetadataRetries(); i++ {
+		tokens = resolver.Resolve(tokens)
+		require.Equal(t, i, tokens[0].Attempts)
+		require.Equal(t, "cannot borrow", tokens[0].Error)
+	}
+	require.False(t, tokens[0].Attempts < cfg.GetMetadataRetries())
+}
+
+func TestResolver_Resolve_Bounded(t *testing.T) {
+	const workers = 3
+
+	var running, maxRunning int32
+	var mu sync.Mutex
+	resolver := newResolver(config.NewNFTConfig([]config.NFTCollectionConfig{topShot}, workers, 3), func(
+		collection config.NFTCollectionConfig, tokenID uint64, owner string, height int64,
+	) (types.NFTMetadata, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		mu.Lock()
+		if current > maxRunning {
+			maxRunning = current
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+		return types.NFTMetadata{Contract: collection.GetIdentifier(), TokenID: tokenID}, nil
+	})
+
+	tokens := make([]types.NFTMetadata, 20)
+	for i := range tokens {
+		tokens[i] = types.NFTMetadata{Contract: topShot.GetIdentifier(), TokenID: uint64(i)}
+	}
+
+	resolved := resolver.Resolve(tokens)
+	require.Len(t, resolved, len(tokens))
+	for i, token := range resolved {
+		require.Equal(t, uint64(i), token.TokenID)
+	}
+
+	require.LessOrEqual(t, maxRunning, int32(workers))
+	require.Greater(t, maxRunning, int32(1))
+}
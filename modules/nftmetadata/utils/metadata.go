@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// GetMetadataScript returns the script that resolves the MetadataViews.Display and MetadataViews.ExternalURL
+// views of a token held by an account inside the given collection
+func GetMetadataScript(collection config.NFTCollectionConfig, metadataViewsAddress string) string {
+	return fmt.Sprintf(`
+	import MetadataViews from %s
+
+	pub fun main(owner: Address, id: UInt64): {String: String} {
+		let collection = getAccount(owner).getCapability(%s)
+			.borrow<&{MetadataViews.ResolverCollection}>()
+			?? panic("Could not borrow the resolver collection")
+		let resolver = collection.borrowViewResolver(id: id)
+
+		let metadata: {String: String} = {}
+		if let view = resolver.resolveView(Type<MetadataViews.Display>()) {
+			let display = view as! MetadataViews.Display
+			metadata["name"] = display.name
+			metadata["description"] = display.description
+			metadata["thumbnail"] = display.thumbnail.uri()
+		}
+		if let view = resolver.resolveView(Type<MetadataViews.ExternalURL>()) {
+			let externalURL = view as! MetadataViews.ExternalURL
+			metadata["external_url"] = externalURL.url
+		}
+		return metadata
+	}`, metadataViewsAddress, collection.PublicPath)
+}
+
+// GetNFTMetadata resolves the metadata of the given token held by the given owner at the given height
+func GetNFTMetadata(
+	collection config.NFTCollectionConfig, tokenID uint64, owner string, height int64, client client.Proxy,
+) (types.NFTMetadata, error) {
+	script := GetMetadataScript(collection, client.Contract().MetadataViews)
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script),
+		[]cadence.Value{cadence.Address(flow.HexToAddress(owner)), cadence.UInt64(tokenID)})
+	if err != nil {
+		return types.NFTMetadata{}, fmt.Errorf("error while resolving metadata of %s %d: %s",
+			collection.GetIdentifier(), tokenID, err)
+	}
+
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return types.NFTMetadata{}, fmt.Errorf("cadence value is not a dictionary: %s", value)
+	}
+
+	views := make(map[string]string, len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		key, err := utils.CadanceConvertString(pair.Key)
+		if err != nil {
+			return types.NFTMetadata{}, err
+		}
+
+		view, err := utils.CadanceConvertString(pair.Value)
+		if err != nil {
+			return types.NFTMetadata{}, err
+		}
+
+		views[key] = view
+	}
+
+	return types.NewNFTMetadata(
+		collection.GetIdentifier(), tokenID, owner,
+		views["name"], views["description"], views["thumbnail"], views["external_url"],
+		uint64(height), 0,
+	), nil
+}
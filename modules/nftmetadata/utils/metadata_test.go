@@ -0,0 +1,18 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/nftmetadata/utils"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+func TestGetMetadataScript(t *testing.T) {
+	collection := config.NewNFTCollectionConfig("TopShot", "0x0b2a3299cc857e29", "/public/MomentCollection")
+	script := utils.GetMetadataScript(collection, "0x1d7e57aa55817448")
+
+	require.Contains(t, script, "import MetadataViews from 0x1d7e57aa55817448")
+	require.Contains(t, script, "getCapability(/public/MomentCollection)")
+}
@@ -1,19 +1,34 @@
 package modules
 
 import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+
 	"github.com/HarleyAppleChoi/junomum/client"
 	"github.com/HarleyAppleChoi/junomum/db"
 	"github.com/HarleyAppleChoi/junomum/db/postgresql"
-	"github.com/cosmos/cosmos-sdk/simapp/params"
-
+	"github.com/HarleyAppleChoi/junomum/modules/accounts"
+	"github.com/HarleyAppleChoi/junomum/modules/actions"
+	"github.com/HarleyAppleChoi/junomum/modules/auth"
+	"github.com/HarleyAppleChoi/junomum/modules/balances"
+	"github.com/HarleyAppleChoi/junomum/modules/consensus"
+	"github.com/HarleyAppleChoi/junomum/modules/contracts"
+	"github.com/HarleyAppleChoi/junomum/modules/epoch"
+	"github.com/HarleyAppleChoi/junomum/modules/fees"
+	"github.com/HarleyAppleChoi/junomum/modules/keys"
+	"github.com/HarleyAppleChoi/junomum/modules/lockedtokens"
 	"github.com/HarleyAppleChoi/junomum/modules/messages"
 	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/modules/nft"
+	"github.com/HarleyAppleChoi/junomum/modules/nftmetadata"
 	"github.com/HarleyAppleChoi/junomum/modules/registrar"
-	"github.com/HarleyAppleChoi/junomum/types"
-
-	"github.com/HarleyAppleChoi/junomum/modules/auth"
-	"github.com/HarleyAppleChoi/junomum/modules/consensus"
+	"github.com/HarleyAppleChoi/junomum/modules/rewards"
+	"github.com/HarleyAppleChoi/junomum/modules/staking"
+	"github.com/HarleyAppleChoi/junomum/modules/stakingevents"
 	"github.com/HarleyAppleChoi/junomum/modules/telemetry"
+	"github.com/HarleyAppleChoi/junomum/modules/token"
+	"github.com/HarleyAppleChoi/junomum/modules/transfers"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
 )
 
 var (
@@ -38,11 +53,33 @@ func (r *Registrar) BuildModules(
 ) modules.Modules {
 
 	bigDipperBd := postgresql.Cast(database)
+	bdCfg := config.Cast(cfg)
 
-	return []modules.Module{
+	mods := []modules.Module{
 		messages.NewModule(r.parser, encodingConfig.Marshaler, database),
-		auth.NewModule(r.parser, *cp, encodingConfig, bigDipperBd),
+		auth.NewModule(bdCfg.GetAuthConfig(), r.parser, *cp, encodingConfig, bigDipperBd),
 		consensus.NewModule(r.parser, *cp, encodingConfig, bigDipperBd),
 		telemetry.NewModule(cfg, r.parser, *cp, encodingConfig, bigDipperBd),
+		actions.NewModule(r.parser, *cp, encodingConfig, bigDipperBd),
+		staking.NewModule(r.parser, *cp, encodingConfig, bigDipperBd),
+		token.NewModule(bdCfg.GetTokenConfig(), r.parser, *cp, encodingConfig, bigDipperBd),
+		transfers.NewModule(bdCfg.GetTokenConfig(), r.parser, *cp, encodingConfig, bigDipperBd),
+		balances.NewModule(bdCfg.GetTokenConfig(), r.parser, *cp, encodingConfig, bigDipperBd),
+		nft.NewModule(r.parser, *cp, encodingConfig, bigDipperBd),
+		contracts.NewModule(*cp, bigDipperBd),
+		accounts.NewModule(*cp, bigDipperBd),
+		keys.NewModule(*cp, bigDipperBd),
+		fees.NewModule(*cp, bigDipperBd),
+		epoch.NewModule(*cp, bigDipperBd),
+		stakingevents.NewModule(*cp, bigDipperBd),
+		rewards.NewModule(*cp, bigDipperBd),
+		lockedtokens.NewModule(*cp, bigDipperBd),
 	}
+
+	// The metadata can only be resolved for the configured collections
+	if nftCfg := bdCfg.GetNFTConfig(); len(nftCfg.GetCollections()) != 0 {
+		mods = append(mods, nftmetadata.NewModule(nftCfg, *cp, bigDipperBd))
+	}
+
+	return mods
 }
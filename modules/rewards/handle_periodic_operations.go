@@ -0,0 +1,93 @@
+package rewards
+
+import (
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	rewardsutils "github.com/HarleyAppleChoi/junomum/modules/rewards/utils"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// Register registers the utils that should be run periodically
+func Register(scheduler *gocron.Scheduler, db *database.Db) error {
+	log.Debug().Str("module", "rewards").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return updateEpochRewards(db) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// maxRewardAttempts represents the number of times the rewards of an epoch are computed
+// while waiting for their payment to be stored, before giving up
+const maxRewardAttempts = 24
+
+// updateEpochRewards computes the rewards of all the ended epochs whose rewards have not been paid yet.
+// Epochs whose rewards cannot be computed are counted as attempted, so that they do not block the following ones
+func updateEpochRewards(db *database.Db) error {
+	epochs, err := db.GetEpochsWithoutRewards(maxRewardAttempts)
+	if err != nil {
+		return err
+	}
+
+	for _, epoch := range epochs {
+		err = UpdateEpochRewards(epoch, db)
+		if err != nil {
+			log.Error().Str("module", "rewards").Uint64("epoch", epoch.Counter).Err(err).
+				Msg("error while updating epoch rewards")
+		}
+
+		err = db.SaveEpochRewardAttempt(epoch.Counter)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateEpochRewards computes the rewards of the given epoch, using the latest staking snapshots taken during it,
+// and reconciles them with the rewards paid before the beginning of the following epoch
+func UpdateEpochRewards(epoch types.EpochRange, db *database.Db) error {
+	log.Trace().Str("module", "rewards").Uint64("epoch", epoch.Counter).Msg("updating epoch rewards")
+
+	payout, cut, found, err := db.GetRewardParameters(epoch.EndHeight)
+	if err != nil || !found {
+		return err
+	}
+
+	nodes, err := db.GetNodeStakes(epoch.StartHeight, epoch.EndHeight)
+	if err != nil {
+		return err
+	}
+
+	delegators, err := db.GetDelegatorStakes(epoch.StartHeight, epoch.EndHeight)
+	if err != nil {
+		return err
+	}
+
+	// Rewards are paid while transitioning to the following epoch, whose first block is the one after the end height
+	paid, err := db.GetPaidRewards(epoch.StartHeight, epoch.EndHeight+1)
+	if err != nil {
+		return err
+	}
+
+	if len(nodes) == 0 && len(paid) == 0 {
+		return nil
+	}
+
+	params := rewardsutils.RewardParameters{Payout: payout, CutPercentage: cut}
+	nodeRewards, delegatorRewards := rewardsutils.ComputeEpochRewards(epoch, params, nodes, delegators, paid)
+
+	err = db.SaveNodeEpochRewards(nodeRewards)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveDelegatorEpochRewards(delegatorRewards)
+}
@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+const (
+	// accessNodeRole represents the role of access nodes, which do not receive any reward
+	accessNodeRole = 5
+
+	// ufix64Factor represents the scaling factor of UFix64 values
+	ufix64Factor = 100000000
+
+	// RewardTolerance represents the maximum difference between the computed and the paid rewards,
+	// in UFix64 units, that is caused by the rounding performed by the FlowIDTableStaking contract
+	RewardTolerance = 1000
+
+	// defaultEpochDuration represents the duration used to compute the APY when the epoch duration is not known
+	defaultEpochDuration = 7 * 24 * time.Hour
+
+	year = 365 * 24 * time.Hour
+)
+
+// RewardParameters contains the values used by FlowIDTableStaking to pay the rewards of an epoch
+type RewardParameters struct {
+	Payout        uint64
+	CutPercentage uint64
+}
+
+// ComputeEpochRewards computes the rewards earned by each node and delegator during the given epoch, using the same
+// formula of the FlowIDTableStaking contract: the payout is split proportionally to the tokens staked by all the
+// nodes other than access nodes and by their delegators, and the node operators take the cut percentage of the
+// rewards of their delegators. The computed figures are then reconciled with the given paid rewards
+func ComputeEpochRewards(
+	epoch types.EpochRange, params RewardParameters,
+	nodes []types.NodeStake, delegators []types.DelegatorStake, paid []types.PaidReward,
+) ([]types.NodeEpochReward, []types.DelegatorEpochReward) {
+	rewardedNodes := make(map[string]bool, len(nodes))
+	totalStaked := new(big.Int)
+	for _, node := range nodes {
+		if node.Role == accessNodeRole {
+			continue
+		}
+		rewardedNodes[node.NodeID] = true
+		totalStaked.Add(totalStaked, new(big.Int).SetUint64(node.TokensStaked))
+	}
+	for _, delegator := range delegators {
+		if rewardedNodes[delegator.NodeID] {
+			totalStaked.Add(totalStaked, new(big.Int).SetUint64(delegator.TokensStaked))
+		}
+	}
+
+	share := func(staked uint64) uint64 {
+		if totalStaked.Sign() == 0 {
+			return 0
+		}
+		amount := new(big.Int).Mul(new(big.Int).SetUint64(params.Payout), new(big.Int).SetUint64(staked))
+		return amount.Quo(amount, totalStaked).Uint64()
+	}
+
+	// Compute the rewards of the delegators first, so that the cuts can be added to the ones of the nodes
+	nodeRewards := make(map[string]uint64, len(nodes))
+	delegatorRewards := make([]uint64, len(delegators))
+	for i, delegator := range delegators {
+		if !rewardedNodes[delegator.NodeID] {
+			continue
+		}
+
+		gross := share(delegator.TokensStaked)
+		cut := new(big.Int).Mul(new(big.Int).SetUint64(gross), new(big.Int).SetUint64(params.CutPercentage))
+		cutAmount := cut.Quo(cut, big.NewInt(ufix64Factor)).Uint64()
+
+		delegatorRewards[i] = gross - cutAmount
+		nodeRewards[delegator.NodeID] += cutAmount
+	}
+
+	paidNodes, paidDelegators := groupPaidRewards(paid)
+
+	nodeStakes := make(map[string]uint64, len(nodes))
+	for _, node := range nodes {
+		nodeStakes[node.NodeID] = node.TokensStaked
+		if rewardedNodes[node.NodeID] {
+			nodeRewards[node.NodeID] += share(node.TokensStaked)
+		}
+	}
+
+	// Nodes that have been paid without appearing in the snapshot are stored as well, so that they are not lost
+	for nodeID := range paidNodes {
+		if _, found := nodeStakes[nodeID]; !found {
+			nodeStakes[nodeID] = 0
+		}
+	}
+
+	nodeIDs := make([]string, 0, len(nodeStakes))
+	for nodeID := range nodeStakes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+
+	nodeEpochRewards := make([]types.NodeEpochReward, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		staked, computed, paidReward := nodeStakes[nodeID], nodeRewards[nodeID], paidNodes[nodeID]
+		nodeEpochRewards[i] = types.NewNodeEpochReward(epoch.Counter, nodeID, staked, computed, paidReward,
+			IsReconciled(computed, paidReward), GetAPY(staked, computed, paidReward, epoch.Duration))
+	}
+
+	delegatorEpochRewards := make([]types.DelegatorEpochReward, len(delegators))
+	for i, delegator := range delegators {
+		paidReward := paidDelegators[delegatorKey{delegator.NodeID, delegator.DelegatorID}]
+		delegatorEpochRewards[i] = types.NewDelegatorEpochReward(epoch.Counter, delegator.NodeID,
+			delegator.DelegatorID, delegator.TokensStaked, delegatorRewards[i], paidReward,
+			IsReconciled(delegatorRewards[i], paidReward),
+			GetAPY(delegator.TokensStaked, delegatorRewards[i], paidReward, epoch.Duration))
+	}
+
+	return nodeEpochRewards, delegatorEpochRewards
+}
+
+// delegatorKey identifies a delegator of a node
+type delegatorKey struct {
+	nodeID      string
+	delegatorID uint32
+}
+
+// groupPaidRewards returns the total amount paid to each node and delegator
+func groupPaidRewards(paid []types.PaidReward) (map[string]*uint64, map[delegatorKey]*uint64) {
+	nodes := make(map[string]*uint64)
+	delegators := make(map[delegatorKey]*uint64)
+	for _, reward := range paid {
+		var total *uint64
+		if reward.DelegatorID == nil {
+			if nodes[reward.NodeID] == nil {
+				nodes[reward.NodeID] = new(uint64)
+			}
+			total = nodes[reward.NodeID]
+		} else {
+			key := delegatorKey{reward.NodeID, *reward.DelegatorID}
+			if delegators[key] == nil {
+				delegators[key] = new(uint64)
+			}
+			total = delegators[key]
+		}
+		*total += reward.Amount
+	}
+	return nodes, delegators
+}
+
+// IsReconciled tells whether the given computed rewards match the paid ones, net of the contract rounding
+func IsReconciled(computed uint64, paid *uint64) bool {
+	if paid == nil {
+		return false
+	}
+	if computed > *paid {
+		return computed-*paid <= RewardTolerance
+	}
+	return *paid-computed <= RewardTolerance
+}
+
+// GetAPY returns the annual percentage yield of the given staked tokens, considering the paid rewards when
+// present and the computed ones otherwise. The yield is not compounded, as rewards are not staked automatically
+func GetAPY(staked uint64, computed uint64, paid *uint64, duration time.Duration) float64 {
+	if staked == 0 {
+		return 0
+	}
+
+	reward := computed
+	if paid != nil {
+		reward = *paid
+	}
+
+	if duration <= 0 {
+		duration = defaultEpochDuration
+	}
+
+	return float64(reward) / float64(staked) * (float64(year) / float64(duration)) * 100
+}
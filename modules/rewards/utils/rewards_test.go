@@ -0,0 +1,65 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/rewards/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func TestComputeEpochRewards(t *testing.T) {
+	epoch := types.NewEpochRange(10, 100, 199, 7*24*time.Hour)
+	params := utils.RewardParameters{Payout: 1000000, CutPercentage: 10000000}
+
+	nodes := []types.NodeStake{
+		types.NewNodeStake("node-a", 1, 600000),
+		types.NewNodeStake("node-b", 5, 100000),
+		types.NewNodeStake("node-c", 2, 300000),
+	}
+	delegators := []types.DelegatorStake{
+		types.NewDelegatorStake("node-a", 1, 100000),
+		types.NewDelegatorStake("node-b", 1, 50000),
+	}
+
+	delegatorID := uint32(1)
+	paid := []types.PaidReward{
+		types.NewPaidReward("node-a", nil, 600000),
+		types.NewPaidReward("node-a", nil, 10000),
+		types.NewPaidReward("node-c", nil, 250000),
+		types.NewPaidReward("node-a", &delegatorID, 90000),
+		types.NewPaidReward("node-d", nil, 5000),
+	}
+
+	nodeRewards, delegatorRewards := utils.ComputeEpochRewards(epoch, params, nodes, delegators, paid)
+
+	uint64Ptr := func(value uint64) *uint64 { return &value }
+	weeks := float64(365) / 7
+	require.Equal(t, []types.NodeEpochReward{
+		types.NewNodeEpochReward(10, "node-a", 600000, 610000, uint64Ptr(610000), true, float64(610000)/600000*weeks*100),
+		types.NewNodeEpochReward(10, "node-b", 100000, 0, nil, false, 0),
+		types.NewNodeEpochReward(10, "node-c", 300000, 300000, uint64Ptr(250000), false, float64(250000)/300000*weeks*100),
+		types.NewNodeEpochReward(10, "node-d", 0, 0, uint64Ptr(5000), false, 0),
+	}, nodeRewards)
+
+	require.Equal(t, []types.DelegatorEpochReward{
+		types.NewDelegatorEpochReward(10, "node-a", 1, 100000, 90000, uint64Ptr(90000), true, float64(90000)/100000*weeks*100),
+		types.NewDelegatorEpochReward(10, "node-b", 1, 50000, 0, nil, false, 0),
+	}, delegatorRewards)
+}
+
+func TestIsReconciled(t *testing.T) {
+	paid := uint64(100000)
+	require.True(t, utils.IsReconciled(100000+utils.RewardTolerance, &paid))
+	require.True(t, utils.IsReconciled(100000-utils.RewardTolerance, &paid))
+	require.False(t, utils.IsReconciled(100000+utils.RewardTolerance+1, &paid))
+	require.False(t, utils.IsReconciled(100000, nil))
+}
+
+func TestGetAPY(t *testing.T) {
+	// Unknown durations default to a week
+	require.Equal(t, utils.GetAPY(1000, 10, nil, 7*24*time.Hour), utils.GetAPY(1000, 10, nil, 0))
+	require.Equal(t, float64(0), utils.GetAPY(0, 10, nil, 0))
+}
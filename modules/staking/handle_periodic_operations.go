@@ -0,0 +1,181 @@
+package staking
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	stakingutils "github.com/HarleyAppleChoi/junomum/modules/staking/utils"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+)
+
+// Register registers the utils that should be run periodically
+func Register(scheduler *gocron.Scheduler, db *database.Db, flowClient client.Proxy) error {
+	log.Debug().Str("module", "staking").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return updateStakingTables(db, flowClient) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateStakingTables snapshots the staking tables at the latest height
+func updateStakingTables(db *database.Db, flowClient client.Proxy) error {
+	height, err := flowClient.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	return UpdateStakingTables(height, db, flowClient)
+}
+
+// UpdateStakingTables reads the state of the FlowIDTableStaking contract at the given height
+// and stores it inside the staking tables
+func UpdateStakingTables(height int64, db *database.Db, flowClient client.Proxy) error {
+	log.Trace().Str("module", "staking").Int64("height", height).Msg("updating staking tables")
+
+	err := updateStakingParameters(height, db, flowClient)
+	if err != nil {
+		return err
+	}
+
+	err = updateTables(height, db, flowClient)
+	if err != nil {
+		return err
+	}
+
+	return updateNodes(height, db, flowClient)
+}
+
+// updateStakingParameters stores the stake requirements, the total stake, the epoch payout and the cut percentage
+func updateStakingParameters(height int64, db *database.Db, flowClient client.Proxy) error {
+	requirements, err := stakingutils.GetStakeRequirements(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting stake requirements: %s", err)
+	}
+
+	err = db.SaveStakeRequirements(requirements)
+	if err != nil {
+		return err
+	}
+
+	totalStakeByType, err := stakingutils.GetTotalStakeByType(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting total stake by type: %s", err)
+	}
+
+	err = db.SaveTotalStakeByType(totalStakeByType)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveTotalStake(stakingutils.GetTotalStake(totalStakeByType, height))
+	if err != nil {
+		return err
+	}
+
+	payout, err := stakingutils.GetWeeklyPayout(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting weekly payout: %s", err)
+	}
+
+	err = db.SaveWeeklyPayout(payout)
+	if err != nil {
+		return err
+	}
+
+	cutPercentage, err := stakingutils.GetCutPercentage(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting cut percentage: %s", err)
+	}
+
+	return db.SaveCutPercentage(cutPercentage)
+}
+
+// updateTables stores the staking, proposed and current node tables
+func updateTables(height int64, db *database.Db, flowClient client.Proxy) error {
+	stakingTable, err := stakingutils.GetStakingTable(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting staking table: %s", err)
+	}
+
+	err = db.SaveStakingTable(stakingTable)
+	if err != nil {
+		return err
+	}
+
+	proposedTable, err := stakingutils.GetProposedTable(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting proposed table: %s", err)
+	}
+
+	err = db.SaveProposedTable(proposedTable)
+	if err != nil {
+		return err
+	}
+
+	currentTable, err := stakingutils.GetCurrentTable(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting current table: %s", err)
+	}
+
+	return db.SaveCurrentTable(currentTable)
+}
+
+// updateNodes stores the information of all the nodes inside the staking table and of their delegators.
+// The staking table must have been stored already, since all the node tables reference it
+func updateNodes(height int64, db *database.Db, flowClient client.Proxy) error {
+	nodeInfos, err := stakingutils.GetNodeInfos(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting node infos: %s", err)
+	}
+
+	err = db.SaveNodeInfosFromTable(nodeInfos, uint64(height))
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveNodeUnstakingTokens(stakingutils.GetNodeUnstakingTokens(nodeInfos, height))
+	if err != nil {
+		return err
+	}
+
+	totalCommitments, totalCommitmentsWithoutDelegators, err := stakingutils.GetNodeTotalCommitments(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting nodes total commitment: %s", err)
+	}
+
+	err = db.SaveNodeTotalCommitment(totalCommitments)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveNodeTotalCommitmentWithoutDelegators(totalCommitmentsWithoutDelegators)
+	if err != nil {
+		return err
+	}
+
+	for _, nodeInfo := range nodeInfos {
+		if len(nodeInfo.Delegators) == 0 {
+			continue
+		}
+
+		delegatorInfos, err := stakingutils.GetDelegatorInfos(nodeInfo.Id, height, flowClient)
+		if err != nil {
+			return fmt.Errorf("error while getting delegators of node %s: %s", nodeInfo.Id, err)
+		}
+
+		err = db.SaveDelegatorInfo(delegatorInfos, uint64(height))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
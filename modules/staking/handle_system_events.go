@@ -0,0 +1,30 @@
+package staking
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleSystemEvents snapshots the staking tables each time a new epoch starts. The NewEpoch event is emitted
+// by the system chunk transaction, so it is fetched by type instead of being read from the block transactions
+func HandleSystemEvents(block *flow.Block, events []types.Event, db *db.Db, flowClient client.Proxy) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "staking").Uint64("height", block.Height).Msg("new epoch started")
+
+	return UpdateStakingTables(int64(block.Height), db, flowClient)
+}
+
+// newEpochEventType returns the type of the event emitted by FlowIDTableStaking when a new epoch starts
+func newEpochEventType(contracts client.Contracts) string {
+	return fmt.Sprintf("A.%s.FlowIDTableStaking.NewEpoch", strings.TrimPrefix(contracts.StakingTable, "0x"))
+}
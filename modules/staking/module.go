@@ -0,0 +1,61 @@
+package staking
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/go-co-op/gocron"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.SystemEventsModule       = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that snapshots the FlowIDTableStaking contract state
+type Module struct {
+	messagesParser messages.MessageAddressesParser
+	encodingConfig *params.EncodingConfig
+	flowClient     client.Proxy
+	db             *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(
+	messagesParser messages.MessageAddressesParser,
+	flowClient client.Proxy,
+	encodingConfig *params.EncodingConfig, db *db.Db,
+) *Module {
+	return &Module{
+		messagesParser: messagesParser,
+		encodingConfig: encodingConfig,
+		flowClient:     flowClient,
+		db:             db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "staking"
+}
+
+// SystemEventTypes implements modules.SystemEventsModule
+func (m *Module) SystemEventTypes() []string {
+	return []string{newEpochEventType(m.flowClient.Contract())}
+}
+
+// HandleSystemEvents implements modules.SystemEventsModule
+func (m *Module) HandleSystemEvents(block *flow.Block, events []types.Event) error {
+	return HandleSystemEvents(block, events, m.db, m.flowClient)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return Register(scheduler, m.db, m.flowClient)
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	testutils "github.com/HarleyAppleChoi/junomum/modules/utils"
+)
+
+func TestStakingProxyTestSuite(t *testing.T) {
+	suite.Run(t, new(StakingProxyTestSuite))
+}
+
+// StakingProxyTestSuite the base test case class for Staking module
+type StakingProxyTestSuite struct {
+	testutils.ProxyTestSuite
+}
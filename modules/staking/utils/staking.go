@@ -0,0 +1,317 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// ExecuteScript executes the given script at the given height, replacing the %s placeholder
+// of its FlowIDTableStaking import with the address of the staking table contract
+func ExecuteScript(script string, height int64, client client.Proxy, arguments ...cadence.Value) (cadence.Value, error) {
+	script = fmt.Sprintf(script, client.Contract().StakingTable)
+	return client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), arguments)
+}
+
+// GetStakeRequirements returns the minimum stake requirements of each node role
+func GetStakeRequirements(height int64, client client.Proxy) ([]types.StakeRequirements, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): {UInt8: UFix64} {
+		return FlowIDTableStaking.getMinimumStakeRequirements()
+	}`
+
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts, err := rolesAmountsFromCadence(value)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading stake requirements: %s", err)
+	}
+
+	var requirements []types.StakeRequirements
+	for role, amount := range amounts {
+		requirements = append(requirements, types.NewStakeRequirements(height, role, amount))
+	}
+	return requirements, nil
+}
+
+// GetTotalStakeByType returns the total amount of tokens staked by the nodes of each role
+func GetTotalStakeByType(height int64, client client.Proxy) ([]types.TotalStakeByType, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): {UInt8: UFix64} {
+		return FlowIDTableStaking.getTotalTokensStakedByNodeType()
+	}`
+
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts, err := rolesAmountsFromCadence(value)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading total stake by type: %s", err)
+	}
+
+	var totalStakes []types.TotalStakeByType
+	for role, amount := range amounts {
+		totalStakes = append(totalStakes, types.NewTotalStakeByType(height, int8(role), amount))
+	}
+	return totalStakes, nil
+}
+
+// GetTotalStake returns the total amount of tokens staked by all the nodes
+func GetTotalStake(totalStakeByType []types.TotalStakeByType, height int64) types.TotalStake {
+	var total uint64
+	for _, stake := range totalStakeByType {
+		total += stake.TotalStake
+	}
+	return types.NewTotalStake(height, total)
+}
+
+// GetWeeklyPayout returns the amount of tokens that are paid as rewards at the end of each epoch
+func GetWeeklyPayout(height int64, client client.Proxy) (types.WeeklyPayout, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): UFix64 {
+		return FlowIDTableStaking.getEpochTokenPayout()
+	}`
+
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return types.WeeklyPayout{}, err
+	}
+
+	payout, err := utils.CadenceConvertUint64(value)
+	if err != nil {
+		return types.WeeklyPayout{}, err
+	}
+
+	return types.NewWeeklyPayout(height, payout), nil
+}
+
+// GetCutPercentage returns the percentage of the delegators rewards that is given to the node operators
+func GetCutPercentage(height int64, client client.Proxy) (types.CutPercentage, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): UFix64 {
+		return FlowIDTableStaking.getRewardCutPercentage()
+	}`
+
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return types.CutPercentage{}, err
+	}
+
+	cutPercentage, err := utils.CadenceConvertUint64(value)
+	if err != nil {
+		return types.CutPercentage{}, err
+	}
+
+	return types.NewCutPercentage(cutPercentage, height), nil
+}
+
+// GetStakingTable returns the ids of all the nodes inside the staking table
+func GetStakingTable(height int64, client client.Proxy) (types.StakingTable, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): [String] {
+		return FlowIDTableStaking.getNodeIDs()
+	}`
+
+	nodeIDs, err := getNodeIDs(script, height, client)
+	if err != nil {
+		return types.StakingTable{}, err
+	}
+
+	return types.NewStakingTable(height, nodeIDs), nil
+}
+
+// GetProposedTable returns the ids of the nodes that are proposed to be staked in the next epoch
+func GetProposedTable(height int64, client client.Proxy) (types.ProposedTable, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): [String] {
+		return FlowIDTableStaking.getProposedNodeIDs()
+	}`
+
+	nodeIDs, err := getNodeIDs(script, height, client)
+	if err != nil {
+		return types.ProposedTable{}, err
+	}
+
+	return types.NewProposedTable(height, nodeIDs), nil
+}
+
+// GetCurrentTable returns the ids of the nodes that are staked in the current epoch
+func GetCurrentTable(height int64, client client.Proxy) (types.CurrentTable, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): [String] {
+		return FlowIDTableStaking.getStakedNodeIDs()
+	}`
+
+	nodeIDs, err := getNodeIDs(script, height, client)
+	if err != nil {
+		return types.CurrentTable{}, err
+	}
+
+	return types.NewCurrentTable(height, nodeIDs), nil
+}
+
+// getNodeIDs executes the given script returning an array of node ids
+func getNodeIDs(script string, height int64, client client.Proxy) ([]string, error) {
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.CadenceConvertStringArray(value)
+}
+
+// GetNodeInfos returns the information of all the nodes inside the staking table
+func GetNodeInfos(height int64, client client.Proxy) ([]types.StakerNodeInfo, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): [FlowIDTableStaking.NodeInfo] {
+		let infos: [FlowIDTableStaking.NodeInfo] = []
+		for nodeID in FlowIDTableStaking.getNodeIDs() {
+			infos.append(FlowIDTableStaking.NodeInfo(nodeID: nodeID))
+		}
+		return infos
+	}`
+
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewStakerNodeInfoArrayFromCadence(value)
+}
+
+// GetNodeTotalCommitments returns the total amount of tokens committed to each node,
+// both including and excluding the tokens committed by its delegators
+func GetNodeTotalCommitments(height int64, client client.Proxy) (
+	[]types.NodeTotalCommitment, []types.NodeTotalCommitmentWithoutDelegators, error,
+) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(): {String: [UFix64]} {
+		let commitments: {String: [UFix64]} = {}
+		for nodeID in FlowIDTableStaking.getNodeIDs() {
+			let info = FlowIDTableStaking.NodeInfo(nodeID: nodeID)
+			commitments[nodeID] = [info.totalCommittedWithDelegators(), info.totalCommittedWithoutDelegators()]
+		}
+		return commitments
+	}`
+
+	value, err := ExecuteScript(script, height, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return nil, nil, fmt.Errorf("cadence value is not a dictionary: %s", value)
+	}
+
+	var withDelegators []types.NodeTotalCommitment
+	var withoutDelegators []types.NodeTotalCommitmentWithoutDelegators
+	for _, pair := range dictionary.Pairs {
+		nodeID, err := utils.CadanceConvertString(pair.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		amounts, ok := pair.Value.(cadence.Array)
+		if !ok || len(amounts.Values) != 2 {
+			return nil, nil, fmt.Errorf("invalid commitments of node %s: %s", nodeID, pair.Value)
+		}
+
+		total, err := utils.CadenceConvertUint64(amounts.Values[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		totalWithoutDelegators, err := utils.CadenceConvertUint64(amounts.Values[1])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		withDelegators = append(withDelegators, types.NewNodeTotalCommitment(nodeID, total, height))
+		withoutDelegators = append(withoutDelegators,
+			types.NewNodeTotalCommitmentWithoutDelegators(nodeID, totalWithoutDelegators, height))
+	}
+
+	return withDelegators, withoutDelegators, nil
+}
+
+// GetNodeUnstakingTokens returns the amount of tokens that each of the given nodes is unstaking
+func GetNodeUnstakingTokens(nodeInfos []types.StakerNodeInfo, height int64) []types.NodeUnstakingTokens {
+	unstakingTokens := make([]types.NodeUnstakingTokens, len(nodeInfos))
+	for i, info := range nodeInfos {
+		unstakingTokens[i] = types.NewNodeUnstakingTokens(info.Id, info.TokensUnstaking, height)
+	}
+	return unstakingTokens
+}
+
+// GetDelegatorInfos returns the information of all the delegators of the given node
+func GetDelegatorInfos(nodeID string, height int64, client client.Proxy) ([]types.DelegatorNodeInfo, error) {
+	script := `
+	import FlowIDTableStaking from %s
+
+	pub fun main(nodeID: String): [FlowIDTableStaking.DelegatorInfo] {
+		let infos: [FlowIDTableStaking.DelegatorInfo] = []
+		for delegatorID in FlowIDTableStaking.NodeInfo(nodeID: nodeID).delegators {
+			infos.append(FlowIDTableStaking.DelegatorInfo(nodeID: nodeID, delegatorID: delegatorID))
+		}
+		return infos
+	}`
+
+	value, err := ExecuteScript(script, height, client, cadence.String(nodeID))
+	if err != nil {
+		return nil, err
+	}
+
+	return types.DelegatorNodeInfoArrayFromCadence(value)
+}
+
+// rolesAmountsFromCadence converts the given {UInt8: UFix64} dictionary into a map from role to amount
+func rolesAmountsFromCadence(value cadence.Value) (map[uint8]uint64, error) {
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return nil, fmt.Errorf("cadence value is not a dictionary: %s", value)
+	}
+
+	amounts := make(map[uint8]uint64, len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		role, err := utils.CadenceConvertUint8(pair.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := utils.CadenceConvertUint64(pair.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts[role] = amount
+	}
+	return amounts, nil
+}
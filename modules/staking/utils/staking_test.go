@@ -0,0 +1,24 @@
+package utils
+
+func (suite *StakingProxyTestSuite) TestProxy_GetStakeRequirements() {
+	proxy := *suite.Proxy
+	height, err := proxy.LatestHeight()
+	suite.Require().NoError(err)
+
+	requirements, err := GetStakeRequirements(height, proxy)
+	suite.Require().NoError(err)
+	suite.Require().Len(requirements, 5, "there should be a requirement for each node role")
+}
+
+func (suite *StakingProxyTestSuite) TestProxy_GetNodeInfos() {
+	proxy := *suite.Proxy
+	height, err := proxy.LatestHeight()
+	suite.Require().NoError(err)
+
+	stakingTable, err := GetStakingTable(height, proxy)
+	suite.Require().NoError(err)
+
+	nodeInfos, err := GetNodeInfos(height, proxy)
+	suite.Require().NoError(err)
+	suite.Require().Len(nodeInfos, len(stakingTable.StakingTable))
+}
@@ -0,0 +1,100 @@
+package stakingevents
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	stakingeventsutils "github.com/HarleyAppleChoi/junomum/modules/stakingevents/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// backfillBatchSize represents the number of blocks whose events are read at once during the backfill.
+// It cannot be greater than the number of blocks that can be queried at once from an access node
+const backfillBatchSize = client.MaxEventsHeightRange
+
+// Backfill rebuilds the staking events ledger of the stored blocks, using the FlowIDTableStaking events already
+// stored inside the database and querying the chain for the ones emitted by the system chunk transactions
+func Backfill(db *db.Db, flowClient client.Proxy) error {
+	firstHeight, err := db.GetFirstBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	lastHeight, err := db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	eventTypes := stakingeventsutils.GetTransactionEventTypes(flowClient.Contract().StakingTable)
+	for from := firstHeight; from <= lastHeight; from += backfillBatchSize {
+		to := from + backfillBatchSize - 1
+		if to > lastHeight {
+			to = lastHeight
+		}
+
+		log.Debug().Str("module", "stakingevents").Int64("from", from).Int64("to", to).Msg("backfilling staking events")
+
+		events, err := db.GetEventsByType(eventTypes, from, to)
+		if err != nil {
+			return err
+		}
+
+		var stakingEvents []types.StakingEvent
+		for _, event := range events {
+			stakingEvent, ok, err := stakingeventsutils.ParseStakingEvent(event, flowClient.Contract().StakingTable)
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				stakingEvents = append(stakingEvents, stakingEvent)
+			}
+		}
+
+		systemEvents, err := getStoredSystemChunkEvents(from, to, db, flowClient)
+		if err != nil {
+			return err
+		}
+
+		err = db.SaveStakingEvents(append(stakingEvents, systemEvents...))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getStoredSystemChunkEvents returns the staking events emitted by the system chunk transactions
+// of the blocks between the given heights that are stored inside the database
+func getStoredSystemChunkEvents(
+	fromHeight int64, toHeight int64, db *db.Db, flowClient client.Proxy,
+) ([]types.StakingEvent, error) {
+	heights, err := db.GetBlockHeights(fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(heights) == 0 {
+		return nil, nil
+	}
+
+	stored := make(map[uint64]bool, len(heights))
+	for _, height := range heights {
+		stored[uint64(height)] = true
+	}
+
+	events, err := getSystemChunkEvents(heights[0], heights[len(heights)-1], flowClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var storedEvents []types.StakingEvent
+	for _, event := range events {
+		if stored[event.Height] {
+			storedEvents = append(storedEvents, event)
+		}
+	}
+	return storedEvents, nil
+}
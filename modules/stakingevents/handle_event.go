@@ -0,0 +1,24 @@
+package stakingevents
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	stakingeventsutils "github.com/HarleyAppleChoi/junomum/modules/stakingevents/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleEvent stores the given event if it has been emitted by the FlowIDTableStaking contract.
+// The events emitted by the system chunk transaction are stored by HandleSystemEvents instead
+func HandleEvent(event types.Event, db *db.Db, flowClient client.Proxy) error {
+	stakingEvent, ok, err := stakingeventsutils.ParseStakingEvent(event, flowClient.Contract().StakingTable)
+	if err != nil || !ok || stakingeventsutils.IsSystemChunkEvent(stakingEvent.Type) {
+		return err
+	}
+
+	log.Debug().Str("module", "stakingevents").Str("type", stakingEvent.Type).
+		Str("node", stakingEvent.NodeID).Msg("staking event")
+
+	return db.SaveStakingEvents([]types.StakingEvent{stakingEvent})
+}
@@ -0,0 +1,68 @@
+package stakingevents
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	stakingeventsutils "github.com/HarleyAppleChoi/junomum/modules/stakingevents/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// HandleSystemEvents stores the staking events emitted by the system chunk transaction of the given block,
+// which are fetched by type since that transaction is not part of any collection
+func HandleSystemEvents(block *flow.Block, events []types.Event, db *db.Db, flowClient client.Proxy) error {
+	stakingEvents, err := parseStakingEvents(events, flowClient.Contract().StakingTable)
+	if err != nil {
+		return err
+	}
+
+	if len(stakingEvents) != 0 {
+		log.Debug().Str("module", "stakingevents").Uint64("height", block.Height).
+			Int("events", len(stakingEvents)).Msg("system chunk staking events")
+	}
+
+	return db.SaveStakingEvents(stakingEvents)
+}
+
+// getSystemChunkEvents returns the staking events emitted by the system chunk transactions
+// of the blocks between the given heights, both included
+func getSystemChunkEvents(fromHeight int64, toHeight int64, flowClient client.Proxy) ([]types.StakingEvent, error) {
+	stakingTable := flowClient.Contract().StakingTable
+
+	var stakingEvents []types.StakingEvent
+	for _, eventType := range stakingeventsutils.GetSystemChunkEventTypes(stakingTable) {
+		events, err := flowClient.EventsOfTypeInRange(eventType, fromHeight, toHeight)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting %s events: %s", eventType, err)
+		}
+
+		parsed, err := parseStakingEvents(events, stakingTable)
+		if err != nil {
+			return nil, err
+		}
+		stakingEvents = append(stakingEvents, parsed...)
+	}
+
+	return stakingEvents, nil
+}
+
+// parseStakingEvents returns the staking events represented by the given events that have been emitted
+// by the FlowIDTableStaking contract deployed at the given address
+func parseStakingEvents(events []types.Event, stakingTable string) ([]types.StakingEvent, error) {
+	var stakingEvents []types.StakingEvent
+	for _, event := range events {
+		stakingEvent, ok, err := stakingeventsutils.ParseStakingEvent(event, stakingTable)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			stakingEvents = append(stakingEvents, stakingEvent)
+		}
+	}
+	return stakingEvents, nil
+}
@@ -0,0 +1,57 @@
+package stakingevents
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	stakingeventsutils "github.com/HarleyAppleChoi/junomum/modules/stakingevents/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+var (
+	_ modules.Module             = &Module{}
+	_ modules.MessageModule      = &Module{}
+	_ modules.SystemEventsModule = &Module{}
+	_ modules.BackfillModule     = &Module{}
+)
+
+// Module represents the module that keeps a ledger of the FlowIDTableStaking events
+type Module struct {
+	flowClient client.Proxy
+	db         *db.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(flowClient client.Proxy, db *db.Db) *Module {
+	return &Module{
+		flowClient: flowClient,
+		db:         db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "stakingevents"
+}
+
+// HandleEvent implements modules.MessageModule
+func (m *Module) HandleEvent(index int, event types.Event, tx *types.Tx) error {
+	return HandleEvent(event, m.db, m.flowClient)
+}
+
+// SystemEventTypes implements modules.SystemEventsModule
+func (m *Module) SystemEventTypes() []string {
+	return stakingeventsutils.GetSystemChunkEventTypes(m.flowClient.Contract().StakingTable)
+}
+
+// HandleSystemEvents implements modules.SystemEventsModule
+func (m *Module) HandleSystemEvents(block *flow.Block, events []types.Event) error {
+	return HandleSystemEvents(block, events, m.db, m.flowClient)
+}
+
+// Backfill implements modules.BackfillModule
+func (m *Module) Backfill() error {
+	return Backfill(m.db, m.flowClient)
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// StakingEventNames contains the names of the events emitted by the FlowIDTableStaking contract
+var StakingEventNames = []string{
+	"NewEpoch",
+	"EpochTotalRewardsPaid",
+	"NewNodeCreated",
+	"TokensCommitted",
+	"TokensStaked",
+	"TokensUnstaking",
+	"TokensUnstaked",
+	"NodeRemovedAndRefunded",
+	"RewardsPaid",
+	"UnstakedTokensWithdrawn",
+	"RewardTokensWithdrawn",
+	"NetworkingAddressUpdated",
+	"NewDelegatorCutPercentage",
+	"NewDelegatorCreated",
+	"DelegatorTokensCommitted",
+	"DelegatorTokensStaked",
+	"DelegatorTokensUnstaking",
+	"DelegatorTokensUnstaked",
+	"DelegatorRewardsPaid",
+	"DelegatorUnstakedTokensWithdrawn",
+	"DelegatorRewardTokensWithdrawn",
+	"NewWeeklyPayout",
+	"NewStakingMinimums",
+}
+
+// SystemChunkEventNames contains the names of the FlowIDTableStaking events emitted by the system chunk
+// transaction when an epoch ends: rewards payments and the stake moved to the new epoch.
+// Since that transaction is not part of any collection, these events must be queried by type
+var SystemChunkEventNames = []string{
+	"NewEpoch",
+	"EpochTotalRewardsPaid",
+	"RewardsPaid",
+	"DelegatorRewardsPaid",
+	"TokensStaked",
+	"TokensUnstaked",
+	"DelegatorTokensStaked",
+	"DelegatorTokensUnstaked",
+	"NodeRemovedAndRefunded",
+}
+
+// GetSystemChunkEventTypes returns the types of the system chunk events emitted by the FlowIDTableStaking
+// contract deployed at the given address
+func GetSystemChunkEventTypes(stakingTable string) []string {
+	prefix := fmt.Sprintf("A.%s.FlowIDTableStaking.", strings.TrimPrefix(stakingTable, "0x"))
+	eventTypes := make([]string, len(SystemChunkEventNames))
+	for i, name := range SystemChunkEventNames {
+		eventTypes[i] = prefix + name
+	}
+	return eventTypes
+}
+
+// IsSystemChunkEvent tells whether the event having the given name is queried by type instead of being read
+// from the block transactions
+func IsSystemChunkEvent(name string) bool {
+	for _, systemName := range SystemChunkEventNames {
+		if name == systemName {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTransactionEventTypes returns the types of the events emitted by the FlowIDTableStaking contract deployed
+// at the given address that are emitted by the block transactions, and that are not queried by type
+func GetTransactionEventTypes(stakingTable string) []string {
+	prefix := fmt.Sprintf("A.%s.FlowIDTableStaking.", strings.TrimPrefix(stakingTable, "0x"))
+
+	var eventTypes []string
+	for _, name := range StakingEventNames {
+		if !IsSystemChunkEvent(name) {
+			eventTypes = append(eventTypes, prefix+name)
+		}
+	}
+	return eventTypes
+}
+
+// ParseStakingEvent returns the staking event represented by the given event, if it has been emitted by
+// the FlowIDTableStaking contract deployed at the given address. Otherwise, false is returned
+func ParseStakingEvent(event types.Event, stakingTable string) (types.StakingEvent, bool, error) {
+	prefix := fmt.Sprintf("A.%s.FlowIDTableStaking.", strings.TrimPrefix(stakingTable, "0x"))
+	if !strings.HasPrefix(event.Type, prefix) {
+		return types.StakingEvent{}, false, nil
+	}
+
+	var nodeID string
+	if value, ok := event.Field("nodeID"); ok {
+		id, err := utils.CadanceConvertString(value)
+		if err != nil {
+			return types.StakingEvent{}, false, err
+		}
+		nodeID = id
+	}
+
+	var delegatorID *uint32
+	if value, ok := event.Field("delegatorID"); ok {
+		id, err := utils.CadenceConvertUint32(value)
+		if err != nil {
+			return types.StakingEvent{}, false, err
+		}
+		delegatorID = &id
+	}
+
+	var amount *uint64
+	for _, field := range []string{"amount", "amountCommitted"} {
+		if value, ok := event.Field(field); ok {
+			tokens, err := utils.CadenceConvertUint64(value)
+			if err != nil {
+				return types.StakingEvent{}, false, err
+			}
+			amount = &tokens
+			break
+		}
+	}
+
+	fields, err := event.FieldsJSON()
+	if err != nil {
+		return types.StakingEvent{}, false, err
+	}
+
+	return types.NewStakingEvent(event.TransactionID, event.EventIndex, uint64(event.Height), event.Name(),
+		nodeID, delegatorID, amount, fields), true, nil
+}
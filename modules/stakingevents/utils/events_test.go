@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/stakingevents/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+const stakingTable = "0x8624b52f9ddcd04a"
+
+func TestParseStakingEvent(t *testing.T) {
+	nodeID := "2cfab7e9163475282f67186b06ce6eea7fa0687d25dd9c7a84532f2016bc2e5e"
+
+	// Delegator event
+	event := testutil.NewEvent(10, "A.8624b52f9ddcd04a.FlowIDTableStaking.DelegatorRewardsPaid", 2,
+		testutil.StringField("nodeID", nodeID),
+		testutil.NewEventField("delegatorID", cadence.UInt32Type{}, cadence.NewUInt32(3)),
+		testutil.UFix64Field("amount", 150000000),
+	)
+
+	stakingEvent, ok, err := utils.ParseStakingEvent(event, stakingTable)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	delegatorID := uint32(3)
+	amount := uint64(150000000)
+	require.True(t, stakingEvent.Equal(types.NewStakingEvent("0x6", 2, 10, "DelegatorRewardsPaid", nodeID,
+		&delegatorID, &amount, []byte(`{"amount":"1.50000000","delegatorID":3,"nodeID":"`+nodeID+`"}`),
+	)), string(stakingEvent.Fields))
+
+	// Node creation, whose amount is named differently
+	event = testutil.NewEvent(10, "A.8624b52f9ddcd04a.FlowIDTableStaking.NewNodeCreated", 2,
+		testutil.StringField("nodeID", nodeID),
+		testutil.NewEventField("role", cadence.UInt8Type{}, cadence.NewUInt8(1)),
+		testutil.UFix64Field("amountCommitted", 150000000),
+	)
+
+	stakingEvent, ok, err = utils.ParseStakingEvent(event, stakingTable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, nodeID, stakingEvent.NodeID)
+	require.Nil(t, stakingEvent.DelegatorID)
+	require.Equal(t, amount, *stakingEvent.Amount)
+
+	// Events emitted by other contracts are ignored
+	event = testutil.NewEvent(10, "A.1654653399040a61.FlowToken.TokensDeposited", 2)
+	_, ok, err = utils.ParseStakingEvent(event, stakingTable)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestGetSystemChunkEventTypes(t *testing.T) {
+	eventTypes := utils.GetSystemChunkEventTypes(stakingTable)
+	require.Len(t, eventTypes, len(utils.SystemChunkEventNames))
+	require.Contains(t, eventTypes, "A.8624b52f9ddcd04a.FlowIDTableStaking.RewardsPaid")
+	require.Contains(t, eventTypes, "A.8624b52f9ddcd04a.FlowIDTableStaking.NewEpoch")
+}
+
+func TestGetTransactionEventTypes(t *testing.T) {
+	eventTypes := utils.GetTransactionEventTypes("0x8624b52f9ddcd04a")
+	require.Len(t, eventTypes, len(utils.StakingEventNames)-len(utils.SystemChunkEventNames))
+	require.Contains(t, eventTypes, "A.8624b52f9ddcd04a.FlowIDTableStaking.TokensCommitted")
+	require.NotContains(t, eventTypes, "A.8624b52f9ddcd04a.FlowIDTableStaking.DelegatorRewardsPaid")
+}
@@ -0,0 +1,49 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	database "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	tokenutils "github.com/HarleyAppleChoi/junomum/modules/token/utils"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// Register registers the utils that should be run periodically
+func Register(scheduler *gocron.Scheduler, cfg *config.TokenConfig, db *database.Db, flowClient client.Proxy) error {
+	log.Debug().Str("module", "token").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(cfg.GetSupplyInterval()).Minutes().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return updateLatestSupply(db, flowClient) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateLatestSupply stores the total supply at the latest height
+func updateLatestSupply(db *database.Db, flowClient client.Proxy) error {
+	height, err := flowClient.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	return UpdateSupply(height, db, flowClient)
+}
+
+// UpdateSupply stores the total supply of FLOW at the given height
+func UpdateSupply(height int64, db *database.Db, flowClient client.Proxy) error {
+	log.Trace().Str("module", "token").Int64("height", height).Msg("updating supply")
+
+	supply, err := tokenutils.GetSupply(height, flowClient)
+	if err != nil {
+		return fmt.Errorf("error while getting supply: %s", err)
+	}
+
+	return db.SaveSupply(supply, uint64(height))
+}
@@ -0,0 +1,32 @@
+package token
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/rs/zerolog/log"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// getSupplyEventTypes returns the types of the events emitted when the FLOW total supply changes.
+// The epoch rewards are minted by the system chunk transaction, so these must be fetched per block
+func getSupplyEventTypes(contracts client.Contracts) []string {
+	prefix := fmt.Sprintf("A.%s.FlowToken.", strings.TrimPrefix(contracts.FlowToken, "0x"))
+	return []string{prefix + "TokensMinted", prefix + "TokensBurned"}
+}
+
+// HandleSystemEvents updates the total supply once per block when some FLOW tokens have been minted or burned
+func HandleSystemEvents(block *flow.Block, events []types.Event, db *db.Db, flowClient client.Proxy) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "token").Uint64("height", block.Height).Int("events", len(events)).
+		Msg("FLOW supply changed")
+
+	return UpdateSupply(int64(block.Height), db, flowClient)
+}
@@ -0,0 +1,17 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+)
+
+func TestGetSupplyEventTypes(t *testing.T) {
+	contracts := client.Contracts{FlowToken: "0x1654653399040a61"}
+	require.Equal(t, []string{
+		"A.1654653399040a61.FlowToken.TokensMinted",
+		"A.1654653399040a61.FlowToken.TokensBurned",
+	}, getSupplyEventTypes(contracts))
+}
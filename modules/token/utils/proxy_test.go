@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	testutils "github.com/HarleyAppleChoi/junomum/modules/utils"
+)
+
+func TestTokenProxyTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenProxyTestSuite))
+}
+
+// TokenProxyTestSuite the base test case class for Token module
+type TokenProxyTestSuite struct {
+	testutils.ProxyTestSuite
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+)
+
+// GetSupply returns the total supply of FLOW at the given height
+func GetSupply(height int64, client client.Proxy) (uint64, error) {
+	script := fmt.Sprintf(`
+	import FlowToken from %s
+
+	pub fun main(): UFix64 {
+		return FlowToken.totalSupply
+	}`, client.Contract().FlowToken)
+
+	value, err := client.Client().ExecuteScriptAtBlockHeight(client.Ctx(), uint64(height), []byte(script), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.CadenceConvertUint64(value)
+}
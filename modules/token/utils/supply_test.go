@@ -0,0 +1,11 @@
+package utils
+
+func (suite *TokenProxyTestSuite) TestProxy_GetSupply() {
+	proxy := *suite.Proxy
+	height, err := proxy.LatestHeight()
+	suite.Require().NoError(err)
+
+	supply, err := GetSupply(height, proxy)
+	suite.Require().NoError(err)
+	suite.Require().NotZero(supply)
+}
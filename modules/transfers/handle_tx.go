@@ -0,0 +1,29 @@
+package transfers
+
+import (
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	transfersutils "github.com/HarleyAppleChoi/junomum/modules/transfers/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+// GetTokens returns the identifiers of the tokens whose transfers should be tracked:
+// FlowToken and all the configured fungible tokens
+func GetTokens(cfg *config.TokenConfig, contracts client.Contracts) []string {
+	tokens := []string{config.FlowTokenConfig(contracts.FlowToken).GetIdentifier()}
+	for _, token := range cfg.GetFungibleTokens() {
+		tokens = append(tokens, token.GetIdentifier())
+	}
+	return tokens
+}
+
+// HandleTx stores the transfers of the given tokens performed by the given transaction
+func HandleTx(tokens []string, db *db.Db, tx *types.Tx) error {
+	transfers, err := transfersutils.GetTransfers(*tx, tokens)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveTokenTransfers(transfers)
+}
@@ -0,0 +1,52 @@
+package transfers
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+
+	"github.com/HarleyAppleChoi/junomum/client"
+	db "github.com/HarleyAppleChoi/junomum/db/postgresql"
+	"github.com/HarleyAppleChoi/junomum/modules/messages"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/config"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+)
+
+// Module represents the module that builds the fungible token transfers ledger
+type Module struct {
+	messagesParser messages.MessageAddressesParser
+	encodingConfig *params.EncodingConfig
+	flowClient     client.Proxy
+	db             *db.Db
+	tokens         []string
+}
+
+// NewModule builds a new Module instance
+func NewModule(
+	cfg *config.TokenConfig,
+	messagesParser messages.MessageAddressesParser,
+	flowClient client.Proxy,
+	encodingConfig *params.EncodingConfig, db *db.Db,
+) *Module {
+	return &Module{
+		messagesParser: messagesParser,
+		encodingConfig: encodingConfig,
+		flowClient:     flowClient,
+		db:             db,
+		tokens:         GetTokens(cfg, flowClient.Contract()),
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "transfers"
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(index int, tx *types.Tx) error {
+	return HandleTx(m.tokens, m.db, tx)
+}
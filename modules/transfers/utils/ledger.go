@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/HarleyAppleChoi/junomum/modules/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// movement represents the tokens moved by a single TokensWithdrawn or TokensDeposited event
+type movement struct {
+	address string
+	amount  uint64
+}
+
+// GetTransfers pairs the TokensWithdrawn and TokensDeposited events emitted by the given transaction
+// for each of the given tokens (eg. A.1654653399040a61.FlowToken) into transfers.
+//
+// Each deposit is paired with the first pending withdrawal of the same amount, if any.
+// Otherwise it consumes the pending withdrawals in order, and any amount that is not covered
+// by a withdrawal is considered as minted. Withdrawals that are never deposited are returned
+// as transfers without a recipient.
+func GetTransfers(tx types.Tx, tokens []string) ([]types.TokenTransfer, error) {
+	var transfers []types.TokenTransfer
+	add := func(token string, from string, to string, amount uint64) {
+		transfers = append(transfers, types.NewTokenTransfer(
+			tx.TransactionID, tx.Height, len(transfers), token, from, to, amount,
+		))
+	}
+
+	for _, token := range tokens {
+		var pending []*movement
+		for _, event := range tx.Events {
+			if !strings.HasPrefix(event.Type, token+".") {
+				continue
+			}
+
+			switch strings.TrimPrefix(event.Type, token+".") {
+			case "TokensWithdrawn":
+				withdrawal, err := getMovement(event, "from")
+				if err != nil {
+					return nil, err
+				}
+				pending = append(pending, withdrawal)
+
+			case "TokensDeposited":
+				deposit, err := getMovement(event, "to")
+				if err != nil {
+					return nil, err
+				}
+
+				if i := findAmount(pending, deposit.amount); i >= 0 {
+					add(token, pending[i].address, deposit.address, deposit.amount)
+					pending = append(pending[:i], pending[i+1:]...)
+					continue
+				}
+
+				for len(pending) > 0 && deposit.amount > 0 {
+					withdrawal := pending[0]
+					amount := withdrawal.amount
+					if deposit.amount < amount {
+						amount = deposit.amount
+					}
+
+					add(token, withdrawal.address, deposit.address, amount)
+					withdrawal.amount -= amount
+					deposit.amount -= amount
+					if withdrawal.amount == 0 {
+						pending = pending[1:]
+					}
+				}
+
+				if deposit.amount > 0 {
+					add(token, "", deposit.address, deposit.amount)
+				}
+			}
+		}
+
+		for _, withdrawal := range pending {
+			add(token, withdrawal.address, "", withdrawal.amount)
+		}
+	}
+
+	return transfers, nil
+}
+
+// getMovement reads the amount and the address stored inside the given field of the event
+func getMovement(event types.Event, addressField string) (*movement, error) {
+	var m movement
+
+	amount, ok := event.Field("amount")
+	if ok {
+		value, err := utils.CadenceConvertUint64(amount)
+		if err != nil {
+			return nil, err
+		}
+		m.amount = value
+	}
+
+	address, ok := event.Field(addressField)
+	if ok {
+		if optional, isOptional := address.(cadence.Optional); isOptional {
+			address = optional.Value
+		}
+		if value, isAddress := address.(cadence.Address); isAddress {
+			m.address = value.Hex()
+		}
+	}
+
+	return &m, nil
+}
+
+// findAmount returns the index of the first movement having the given amount, or -1 if not found
+func findAmount(movements []*movement, amount uint64) int {
+	for i, m := range movements {
+		if m.amount == amount {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,54 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/modules/transfers/utils"
+	"github.com/HarleyAppleChoi/junomum/types"
+	"github.com/HarleyAppleChoi/junomum/types/testutil"
+)
+
+const flowToken = "A.1654653399040a61.FlowToken"
+
+func tokensEvent(name string, addressField string, amount uint64, address []byte) types.Event {
+	return testutil.NewEvent(10, flowToken+"."+name, 0,
+		testutil.UFix64Field("amount", amount),
+		testutil.OptionalAddressField(addressField, address),
+	)
+}
+
+func TestGetTransfers(t *testing.T) {
+	alice := []byte{0x01}
+	bob := []byte{0x02}
+	fees := []byte{0xf9, 0x19, 0xee, 0x77, 0x44, 0x7b, 0x74, 0x97}
+
+	tx := types.NewTx(10, "0x6", nil, nil, "0x2", 100, "", "", nil, nil, nil)
+	tx.Events = []types.Event{
+		// Alice sends 10 to Bob, split in two deposits
+		tokensEvent("TokensWithdrawn", "from", 10, alice),
+		tokensEvent("TokensDeposited", "to", 7, bob),
+		tokensEvent("TokensDeposited", "to", 3, alice),
+
+		// Fees paid by Alice
+		tokensEvent("TokensWithdrawn", "from", 1, alice),
+		tokensEvent("TokensDeposited", "to", 1, fees),
+
+		// Minted tokens deposited to Bob
+		tokensEvent("TokensDeposited", "to", 5, bob),
+
+		// Burned tokens
+		tokensEvent("TokensWithdrawn", "from", 2, bob),
+	}
+
+	transfers, err := utils.GetTransfers(tx, []string{flowToken, "A.3c5959b568896393.FUSD"})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenTransfer{
+		types.NewTokenTransfer("0x6", 10, 0, flowToken, "0000000000000001", "0000000000000002", 7),
+		types.NewTokenTransfer("0x6", 10, 1, flowToken, "0000000000000001", "0000000000000001", 3),
+		types.NewTokenTransfer("0x6", 10, 2, flowToken, "0000000000000001", "f919ee77447b7497", 1),
+		types.NewTokenTransfer("0x6", 10, 3, flowToken, "", "0000000000000002", 5),
+		types.NewTokenTransfer("0x6", 10, 4, flowToken, "0000000000000002", "", 2),
+	}, transfers)
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// CadenceConvertUint64 converts the given cadence value into an uint64
+func CadenceConvertUint64(value cadence.Value) (uint64, error) {
+	val, ok := value.ToGoValue().(uint64)
+	if !ok {
+		return 0, fmt.Errorf("cadence value is not a uint64: %s", value)
+	}
+	return val, nil
+}
+
+// CadenceConvertUint32 converts the given cadence value into an uint32
+func CadenceConvertUint32(value cadence.Value) (uint32, error) {
+	val, ok := value.ToGoValue().(uint32)
+	if !ok {
+		return 0, fmt.Errorf("cadence value is not a uint32: %s", value)
+	}
+	return val, nil
+}
+
+// CadenceConvertUint8 converts the given cadence value into an uint8
+func CadenceConvertUint8(value cadence.Value) (uint8, error) {
+	val, ok := value.ToGoValue().(uint8)
+	if !ok {
+		return 0, fmt.Errorf("cadence value is not a uint8: %s", value)
+	}
+	return val, nil
+}
+
+// CadanceConvertString converts the given cadence value into a string
+func CadanceConvertString(value cadence.Value) (string, error) {
+	val, ok := value.ToGoValue().(string)
+	if !ok {
+		return "", fmt.Errorf("cadence value is not a string: %s", value)
+	}
+	return val, nil
+}
+
+// CadenceConvertStringArray converts the given cadence array into a []string
+func CadenceConvertStringArray(value cadence.Value) ([]string, error) {
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("cadence value is not an array: %s", value)
+	}
+
+	strings := make([]string, len(array.Values))
+	for i, val := range array.Values {
+		str, err := CadanceConvertString(val)
+		if err != nil {
+			return nil, err
+		}
+		strings[i] = str
+	}
+	return strings, nil
+}
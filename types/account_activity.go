@@ -0,0 +1,16 @@
+package types
+
+// AccountActivity represents the height of the latest transaction involving an account.
+// Accounts that have never been involved in a stored transaction have a zero height
+type AccountActivity struct {
+	Address          string
+	LastActiveHeight int64
+}
+
+// NewAccountActivity allows to build a new AccountActivity
+func NewAccountActivity(address string, lastActiveHeight int64) AccountActivity {
+	return AccountActivity{
+		Address:          address,
+		LastActiveHeight: lastActiveHeight,
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+// AccountCreation represents the creation of an account, paid by the creator account
+type AccountCreation struct {
+	Address       string
+	Creator       string
+	TransactionID string
+	Height        uint64
+}
+
+// NewAccountCreation allows to build a new AccountCreation
+func NewAccountCreation(address string, creator string, transactionID string, height uint64) AccountCreation {
+	return AccountCreation{
+		Address:       address,
+		Creator:       creator,
+		TransactionID: transactionID,
+		Height:        height,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountCreation) Equal(w AccountCreation) bool {
+	return v.Address == w.Address &&
+		v.Creator == w.Creator &&
+		v.TransactionID == w.TransactionID &&
+		v.Height == w.Height
+}
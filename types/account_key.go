@@ -0,0 +1,43 @@
+package types
+
+// AccountKeyHistory represents the lifecycle of a single key of an account.
+// The added and revoked heights are zero when not known, and the transaction ids are empty
+// when the change has been observed by a periodic refresh rather than by an event
+type AccountKeyHistory struct {
+	Address              string
+	Index                int
+	PublicKey            string
+	SigAlgo              string
+	HashAlgo             string
+	Weight               int
+	AddedHeight          uint64
+	AddedTransactionID   string
+	RevokedHeight        uint64
+	RevokedTransactionID string
+}
+
+// NewAccountKeyHistory allows to build a new AccountKeyHistory
+func NewAccountKeyHistory(
+	address string,
+	index int,
+	publicKey string,
+	sigAlgo string,
+	hashAlgo string,
+	weight int,
+	addedHeight uint64,
+	addedTransactionID string,
+	revokedHeight uint64,
+	revokedTransactionID string) AccountKeyHistory {
+	return AccountKeyHistory{
+		Address:              address,
+		Index:                index,
+		PublicKey:            publicKey,
+		SigAlgo:              sigAlgo,
+		HashAlgo:             hashAlgo,
+		Weight:               weight,
+		AddedHeight:          addedHeight,
+		AddedTransactionID:   addedTransactionID,
+		RevokedHeight:        revokedHeight,
+		RevokedTransactionID: revokedTransactionID,
+	}
+}
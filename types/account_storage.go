@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// AccountStorage represents the storage used by an account, along with its storage capacity, at a given height.
+// Both values are expressed in bytes
+type AccountStorage struct {
+	Address  string
+	Used     uint64
+	Capacity uint64
+	Height   uint64
+}
+
+// NewAccountStorage allows to build a new AccountStorage
+func NewAccountStorage(address string, used uint64, capacity uint64, height uint64) AccountStorage {
+	return AccountStorage{
+		Address:  address,
+		Used:     used,
+		Capacity: capacity,
+		Height:   height,
+	}
+}
+
+// NewAccountStorageFromCadence builds a new AccountStorage from the [storageUsed, storageCapacity]
+// array returned by a script
+func NewAccountStorageFromCadence(address string, value cadence.Value, height uint64) (AccountStorage, error) {
+	array, ok := value.(cadence.Array)
+	if !ok || len(array.Values) != 2 {
+		return AccountStorage{}, fmt.Errorf("account storage is not an array of two values")
+	}
+
+	used, ok := array.Values[0].ToGoValue().(uint64)
+	if !ok {
+		return AccountStorage{}, fmt.Errorf("storage used is not a uint64")
+	}
+
+	capacity, ok := array.Values[1].ToGoValue().(uint64)
+	if !ok {
+		return AccountStorage{}, fmt.Errorf("storage capacity is not a uint64")
+	}
+
+	return NewAccountStorage(address, used, capacity, height), nil
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountStorage) Equal(w AccountStorage) bool {
+	return v.Address == w.Address &&
+		v.Used == w.Used &&
+		v.Capacity == w.Capacity &&
+		v.Height == w.Height
+}
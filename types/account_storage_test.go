@@ -0,0 +1,24 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+func TestNewAccountStorageFromCadence(t *testing.T) {
+	value := cadence.NewArray([]cadence.Value{cadence.UInt64(95000), cadence.UInt64(100000)})
+
+	storage, err := types.NewAccountStorageFromCadence("1654653399040a61", value, 10)
+	require.NoError(t, err)
+	require.True(t, storage.Equal(types.NewAccountStorage("1654653399040a61", 95000, 100000, 10)))
+
+	_, err = types.NewAccountStorageFromCadence("1654653399040a61", cadence.NewArray([]cadence.Value{cadence.UInt64(95000)}), 10)
+	require.Error(t, err)
+
+	_, err = types.NewAccountStorageFromCadence("1654653399040a61", cadence.UFix64(95000), 10)
+	require.Error(t, err)
+}
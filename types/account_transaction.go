@@ -0,0 +1,71 @@
+package types
+
+const (
+	// AccountTransactionRolePayer identifies the account paying the fees of a transaction
+	AccountTransactionRolePayer = "payer"
+
+	// AccountTransactionRoleProposer identifies the account whose key has been used as proposal key
+	AccountTransactionRoleProposer = "proposer"
+
+	// AccountTransactionRoleAuthorizer identifies an account that authorized a transaction
+	AccountTransactionRoleAuthorizer = "authorizer"
+
+	// AccountTransactionRoleEvent identifies an account referenced inside one of the events emitted by a transaction
+	AccountTransactionRoleEvent = "event"
+)
+
+// AccountTransaction represents the relation between an account and a transaction involving it
+type AccountTransaction struct {
+	Address       string
+	TransactionID string
+	Height        uint64
+	Role          string
+}
+
+// NewAccountTransaction allows to build a new AccountTransaction
+func NewAccountTransaction(address string, transactionID string, height uint64, role string) AccountTransaction {
+	return AccountTransaction{
+		Address:       address,
+		TransactionID: transactionID,
+		Height:        height,
+		Role:          role,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountTransaction) Equal(w AccountTransaction) bool {
+	return v.Address == w.Address &&
+		v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Role == w.Role
+}
+
+// NewAccountTransactions returns the relations between the given transaction and all the accounts involved in it,
+// either as payer, proposer, authorizer or because they are referenced inside the given events.
+// Each account is returned at most once for each role
+func NewAccountTransactions(tx Tx, events []Event) []AccountTransaction {
+	var relations []AccountTransaction
+	added := make(map[AccountTransaction]bool)
+	add := func(address string, role string) {
+		relation := NewAccountTransaction(address, tx.TransactionID, tx.Height, role)
+		if address == "" || added[relation] {
+			return
+		}
+		added[relation] = true
+		relations = append(relations, relation)
+	}
+
+	add(tx.Payer, AccountTransactionRolePayer)
+	add(tx.ProposalKey, AccountTransactionRoleProposer)
+	for _, authorizer := range tx.Authorizers {
+		add(authorizer, AccountTransactionRoleAuthorizer)
+	}
+
+	for _, event := range events {
+		for _, address := range CadenceAddresses(event.Value) {
+			add(address, AccountTransactionRoleEvent)
+		}
+	}
+
+	return relations
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountTransactions(t *testing.T) {
+	eventType := &cadence.EventType{
+		QualifiedIdentifier: "FlowToken.TokensDeposited",
+		Fields: []cadence.Field{
+			{Identifier: "amount", Type: cadence.UFix64Type{}},
+			{Identifier: "to", Type: cadence.OptionalType{Type: cadence.AddressType{}}},
+		},
+	}
+	deposited := func(to cadence.Value) Event {
+		return NewEvent(10, "A.1654653399040a61.FlowToken.TokensDeposited", "0x6", 0, 0,
+			cadence.NewEvent([]cadence.Value{cadence.UFix64(100), to}).WithType(eventType))
+	}
+
+	tx := NewTx(10, "0x6", nil, nil, "0x2", 100,
+		"8d0e87b65159ae63", "8d0e87b65159ae63", []string{"8d0e87b65159ae63", "f233dcee88fe0abe"}, nil, nil)
+
+	relations := NewAccountTransactions(tx, []Event{
+		deposited(cadence.NewOptional(cadence.BytesToAddress([]byte{0x16, 0x54, 0x65, 0x33, 0x99, 0x04, 0x0a, 0x61}))),
+		deposited(cadence.NewOptional(cadence.BytesToAddress([]byte{0x16, 0x54, 0x65, 0x33, 0x99, 0x04, 0x0a, 0x61}))),
+		deposited(cadence.NewOptional(nil)),
+	})
+
+	require.Equal(t, []AccountTransaction{
+		NewAccountTransaction("8d0e87b65159ae63", "0x6", 10, AccountTransactionRolePayer),
+		NewAccountTransaction("8d0e87b65159ae63", "0x6", 10, AccountTransactionRoleProposer),
+		NewAccountTransaction("8d0e87b65159ae63", "0x6", 10, AccountTransactionRoleAuthorizer),
+		NewAccountTransaction("f233dcee88fe0abe", "0x6", 10, AccountTransactionRoleAuthorizer),
+		NewAccountTransaction("1654653399040a61", "0x6", 10, AccountTransactionRoleEvent),
+	}, relations)
+}
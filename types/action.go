@@ -0,0 +1,44 @@
+package types
+
+// TransactionAction represents the typed action performed by a transaction whose script
+// matches one of the known templates. Amount is the raw UFix64 integer (1 FLOW = 100000000)
+type TransactionAction struct {
+	TransactionID string
+	Height        uint64
+	Action        string
+	From          string
+	To            string
+	Amount        uint64
+	NodeID        string
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TransactionAction) Equal(w TransactionAction) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Action == w.Action &&
+		v.From == w.From &&
+		v.To == w.To &&
+		v.Amount == w.Amount &&
+		v.NodeID == w.NodeID
+}
+
+// NewTransactionAction allows to build a new TransactionAction
+func NewTransactionAction(
+	transactionID string,
+	height uint64,
+	action string,
+	from string,
+	to string,
+	amount uint64,
+	nodeID string) TransactionAction {
+	return TransactionAction{
+		TransactionID: transactionID,
+		Height:        height,
+		Action:        action,
+		From:          from,
+		To:            to,
+		Amount:        amount,
+		NodeID:        nodeID,
+	}
+}
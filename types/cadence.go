@@ -0,0 +1,169 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence"
+)
+
+// FlattenCadenceValue converts the given cadence value into a plain Go value that can be
+// marshalled as JSON. Addresses are returned in the same hex format used by the account table,
+// fixed point numbers are returned as decimal strings to preserve their precision, and
+// composite values are returned as maps from field name to flattened field value.
+func FlattenCadenceValue(value cadence.Value) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case cadence.Void:
+		return nil
+	case cadence.Optional:
+		return FlattenCadenceValue(v.Value)
+	case cadence.Address:
+		return v.Hex()
+	case cadence.UFix64:
+		return v.String()
+	case cadence.Fix64:
+		return v.String()
+	case cadence.String:
+		return string(v)
+	case cadence.Bool:
+		return bool(v)
+	case cadence.Array:
+		values := make([]interface{}, len(v.Values))
+		for i, element := range v.Values {
+			values[i] = FlattenCadenceValue(element)
+		}
+		return values
+	case cadence.Dictionary:
+		values := make(map[string]interface{}, len(v.Pairs))
+		for _, pair := range v.Pairs {
+			values[fmt.Sprint(FlattenCadenceValue(pair.Key))] = FlattenCadenceValue(pair.Value)
+		}
+		return values
+	case cadence.Struct:
+		return flattenComposite(v.StructType.Fields, v.Fields)
+	case cadence.Resource:
+		return flattenComposite(v.ResourceType.Fields, v.Fields)
+	case cadence.Event:
+		return flattenComposite(v.EventType.Fields, v.Fields)
+	case cadence.Enum:
+		return flattenComposite(v.EnumType.Fields, v.Fields)
+	case cadence.NumberValue:
+		if bigInt, ok := v.ToGoValue().(*big.Int); ok {
+			return bigInt.String()
+		}
+		return v.ToGoValue()
+	default:
+		return value.String()
+	}
+}
+
+// flattenComposite returns the given composite fields as a map from field name to flattened value
+func flattenComposite(fields []cadence.Field, values []cadence.Value) map[string]interface{} {
+	composite := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		if i >= len(fields) {
+			break
+		}
+		composite[fields[i].Identifier] = FlattenCadenceValue(value)
+	}
+	return composite
+}
+
+// FlattenCadenceFields returns the fields of the given event as a single level map.
+// Nested composite values are joined to their parent field name using a dot,
+// so that every leaf value can be reached with a single JSONB key lookup.
+func FlattenCadenceFields(event cadence.Event) map[string]interface{} {
+	fields := make(map[string]interface{})
+	flattenInto(fields, "", event.EventType.Fields, event.Fields)
+	return fields
+}
+
+// flattenInto stores the given composite values inside fields, prefixing each key with the provided prefix.
+// Values that are composites themselves are expanded recursively.
+func flattenInto(fields map[string]interface{}, prefix string, types []cadence.Field, values []cadence.Value) {
+	for i, value := range values {
+		if i >= len(types) {
+			break
+		}
+
+		key := prefix + types[i].Identifier
+		if optional, ok := value.(cadence.Optional); ok {
+			value = optional.Value
+		}
+
+		switch v := value.(type) {
+		case cadence.Struct:
+			flattenInto(fields, key+".", v.StructType.Fields, v.Fields)
+		case cadence.Resource:
+			flattenInto(fields, key+".", v.ResourceType.Fields, v.Fields)
+		case cadence.Event:
+			flattenInto(fields, key+".", v.EventType.Fields, v.Fields)
+		default:
+			fields[key] = FlattenCadenceValue(value)
+		}
+	}
+}
+
+// CadenceTypeToSQL returns the PostgreSQL type that should be used to represent a value
+// of the given cadence type when projected out of a JSONB column
+func CadenceTypeToSQL(t cadence.Type) string {
+	switch v := t.(type) {
+	case cadence.OptionalType:
+		return CadenceTypeToSQL(v.Type)
+	case cadence.BoolType:
+		return "BOOLEAN"
+	case cadence.UFix64Type, cadence.Fix64Type,
+		cadence.IntType, cadence.Int8Type, cadence.Int16Type, cadence.Int32Type, cadence.Int64Type,
+		cadence.Int128Type, cadence.Int256Type,
+		cadence.UIntType, cadence.UInt8Type, cadence.UInt16Type, cadence.UInt32Type, cadence.UInt64Type,
+		cadence.UInt128Type, cadence.UInt256Type,
+		cadence.Word8Type, cadence.Word16Type, cadence.Word32Type, cadence.Word64Type:
+		return "NUMERIC"
+	case cadence.StringType, cadence.AddressType, cadence.CharacterType:
+		return "TEXT"
+	default:
+		return "JSONB"
+	}
+}
+
+// CadenceAddresses returns all the addresses contained inside the given value, searching
+// recursively inside optionals, arrays, dictionaries and composites.
+// Addresses are returned in the same hex format used by the account table
+func CadenceAddresses(value cadence.Value) []string {
+	switch v := value.(type) {
+	case cadence.Address:
+		return []string{v.Hex()}
+	case cadence.Optional:
+		return CadenceAddresses(v.Value)
+	case cadence.Array:
+		return cadenceAddresses(v.Values)
+	case cadence.Dictionary:
+		var addresses []string
+		for _, pair := range v.Pairs {
+			addresses = append(addresses, CadenceAddresses(pair.Key)...)
+			addresses = append(addresses, CadenceAddresses(pair.Value)...)
+		}
+		return addresses
+	case cadence.Struct:
+		return cadenceAddresses(v.Fields)
+	case cadence.Resource:
+		return cadenceAddresses(v.Fields)
+	case cadence.Event:
+		return cadenceAddresses(v.Fields)
+	case cadence.Enum:
+		return cadenceAddresses(v.Fields)
+	default:
+		return nil
+	}
+}
+
+// cadenceAddresses returns all the addresses contained inside the given values
+func cadenceAddresses(values []cadence.Value) []string {
+	var addresses []string
+	for _, value := range values {
+		addresses = append(addresses, CadenceAddresses(value)...)
+	}
+	return addresses
+}
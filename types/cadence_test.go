@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCadenceFields(t *testing.T) {
+	metadataType := &cadence.StructType{
+		QualifiedIdentifier: "Metadata",
+		Fields: []cadence.Field{
+			{Identifier: "name", Type: cadence.StringType{}},
+		},
+	}
+	eventType := &cadence.EventType{
+		QualifiedIdentifier: "FlowToken.TokensDeposited",
+		Fields: []cadence.Field{
+			{Identifier: "amount", Type: cadence.UFix64Type{}},
+			{Identifier: "to", Type: cadence.OptionalType{Type: cadence.AddressType{}}},
+			{Identifier: "id", Type: cadence.UInt64Type{}},
+			{Identifier: "metadata", Type: metadataType},
+		},
+	}
+
+	event := cadence.NewEvent([]cadence.Value{
+		cadence.UFix64(1000000000),
+		cadence.NewOptional(cadence.BytesToAddress([]byte{0x16, 0x54, 0x65, 0x33, 0x99, 0x04, 0x0a, 0x61})),
+		cadence.UInt64(5),
+		cadence.NewStruct([]cadence.Value{cadence.String("name")}).WithType(metadataType),
+	}).WithType(eventType)
+
+	fields := FlattenCadenceFields(event)
+	require.Equal(t, map[string]interface{}{
+		"amount":        "10.00000000",
+		"to":            "1654653399040a61",
+		"id":            uint64(5),
+		"metadata.name": "name",
+	}, fields)
+}
+
+func TestFlattenCadenceValue_NilOptional(t *testing.T) {
+	require.Nil(t, FlattenCadenceValue(cadence.NewOptional(nil)))
+}
+
+func TestCadenceTypeToSQL(t *testing.T) {
+	require.Equal(t, "NUMERIC", CadenceTypeToSQL(cadence.UFix64Type{}))
+	require.Equal(t, "TEXT", CadenceTypeToSQL(cadence.OptionalType{Type: cadence.AddressType{}}))
+	require.Equal(t, "BOOLEAN", CadenceTypeToSQL(cadence.BoolType{}))
+	require.Equal(t, "JSONB", CadenceTypeToSQL(cadence.VariableSizedArrayType{ElementType: cadence.StringType{}}))
+}
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	juno "github.com/HarleyAppleChoi/junomum/types"
 )
 
@@ -12,35 +16,78 @@ var (
 type Config struct {
 	juno.Config
 	databaseConfig *DatabaseConfig
+	tokenConfig    *TokenConfig
+	nftConfig      *NFTConfig
+	authConfig     *AuthConfig
 }
 
 // NewConfig allows to build a new Config instance
-func NewConfig(junoCfg juno.Config, databaseCfg *DatabaseConfig) juno.Config {
+func NewConfig(junoCfg juno.Config, databaseCfg *DatabaseConfig, tokenCfg *TokenConfig, nftCfg *NFTConfig, authCfg *AuthConfig) juno.Config {
 	return &Config{
 		Config:         junoCfg,
 		databaseConfig: databaseCfg,
+		tokenConfig:    tokenCfg,
+		nftConfig:      nftCfg,
+		authConfig:     authCfg,
 	}
 }
 
+// Cast allows to cast the given config to a Config instance.
+// If the given config is not a Config, a Config using the default values of all the custom sections is returned
+func Cast(cfg juno.Config) *Config {
+	bdCfg, ok := cfg.(*Config)
+	if !ok {
+		return &Config{Config: cfg}
+	}
+	return bdCfg
+}
+
 func (c *Config) GetDatabaseConfig() juno.DatabaseConfig {
 	return c.databaseConfig
 }
 
+// GetTokenConfig returns the configuration of the token module, or the default one if not set
+func (c *Config) GetTokenConfig() *TokenConfig {
+	if c.tokenConfig == nil {
+		return DefaultTokenConfig()
+	}
+	return c.tokenConfig
+}
+
+// GetNFTConfig returns the configuration of the nft related modules, or the default one if not set
+func (c *Config) GetNFTConfig() *NFTConfig {
+	if c.nftConfig == nil {
+		return DefaultNFTConfig()
+	}
+	return c.nftConfig
+}
+
+// GetAuthConfig returns the configuration of the auth module, or the default one if not set
+func (c *Config) GetAuthConfig() *AuthConfig {
+	if c.authConfig == nil {
+		return DefaultAuthConfig()
+	}
+	return c.authConfig
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var _ juno.DatabaseConfig = &DatabaseConfig{}
 
 // DatabaseConfig extends juno.databaseConfig allowing to specify whether or not to store historical data
+// and which event types should be exposed through a typed projection
 type DatabaseConfig struct {
 	juno.DatabaseConfig
-	StoreHistoricalData bool `toml:"store_historical_data"`
+	StoreHistoricalData bool     `toml:"store_historical_data"`
+	EventProjections    []string `toml:"event_projections"`
 }
 
 // NewDatabaseConfig allows to build a new DatabaseConfig instance
-func NewDatabaseConfig(junoDbCfg juno.DatabaseConfig, storeHistoricalData bool) *DatabaseConfig {
+func NewDatabaseConfig(junoDbCfg juno.DatabaseConfig, storeHistoricalData bool, eventProjections []string) *DatabaseConfig {
 	return &DatabaseConfig{
 		DatabaseConfig:      junoDbCfg,
 		StoreHistoricalData: storeHistoricalData,
+		EventProjections:    eventProjections,
 	}
 }
 
@@ -48,3 +95,184 @@ func NewDatabaseConfig(junoDbCfg juno.DatabaseConfig, storeHistoricalData bool)
 func (d *DatabaseConfig) ShouldStoreHistoricalData() bool {
 	return d.StoreHistoricalData
 }
+
+// GetEventProjections returns the event types (eg. A.1654653399040a61.FlowToken.TokensDeposited)
+// for which a typed view should be created on top of the event table
+func (d *DatabaseConfig) GetEventProjections() []string {
+	return d.EventProjections
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// TokenConfig contains the configuration of the token related modules
+type TokenConfig struct {
+	SupplyInterval uint64                `toml:"supply_interval"`
+	FungibleTokens []FungibleTokenConfig `toml:"fungible_tokens"`
+}
+
+// NewTokenConfig allows to build a new TokenConfig instance
+func NewTokenConfig(supplyInterval uint64, fungibleTokens []FungibleTokenConfig) *TokenConfig {
+	return &TokenConfig{
+		SupplyInterval: supplyInterval,
+		FungibleTokens: fungibleTokens,
+	}
+}
+
+// DefaultTokenConfig returns the default TokenConfig instance
+func DefaultTokenConfig() *TokenConfig {
+	return NewTokenConfig(10, nil)
+}
+
+// GetSupplyInterval returns the number of minutes between two consecutive total supply updates
+func (t *TokenConfig) GetSupplyInterval() uint64 {
+	if t.SupplyInterval == 0 {
+		return DefaultTokenConfig().SupplyInterval
+	}
+	return t.SupplyInterval
+}
+
+// GetFungibleTokens returns the fungible tokens that should be tracked other than FLOW
+func (t *TokenConfig) GetFungibleTokens() []FungibleTokenConfig {
+	return t.FungibleTokens
+}
+
+// FungibleTokenConfig identifies a fungible token contract implementing the FungibleToken standard,
+// along with the public path at which accounts expose the balance of their vault
+type FungibleTokenConfig struct {
+	Name        string `toml:"name"`
+	Address     string `toml:"address"`
+	BalancePath string `toml:"balance_path"`
+}
+
+// NewFungibleTokenConfig allows to build a new FungibleTokenConfig instance
+func NewFungibleTokenConfig(name string, address string, balancePath string) FungibleTokenConfig {
+	return FungibleTokenConfig{
+		Name:        name,
+		Address:     address,
+		BalancePath: balancePath,
+	}
+}
+
+// FlowTokenConfig returns the FungibleTokenConfig of the FLOW token deployed at the given address
+func FlowTokenConfig(address string) FungibleTokenConfig {
+	return NewFungibleTokenConfig("FlowToken", address, "/public/flowTokenBalance")
+}
+
+// GetIdentifier returns the identifier used as events prefix by the token contract, eg. A.1654653399040a61.FlowToken
+func (t FungibleTokenConfig) GetIdentifier() string {
+	return fmt.Sprintf("A.%s.%s", strings.TrimPrefix(t.Address, "0x"), t.Name)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NFTConfig contains the configuration of the nft related modules
+type NFTConfig struct {
+	Collections     []NFTCollectionConfig `toml:"collections"`
+	MetadataWorkers int                   `toml:"metadata_workers"`
+	MetadataRetries int                   `toml:"metadata_retries"`
+}
+
+// NewNFTConfig allows to build a new NFTConfig instance
+func NewNFTConfig(collections []NFTCollectionConfig, metadataWorkers int, metadataRetries int) *NFTConfig {
+	return &NFTConfig{
+		Collections:     collections,
+		MetadataWorkers: metadataWorkers,
+		MetadataRetries: metadataRetries,
+	}
+}
+
+// DefaultNFTConfig returns the default NFTConfig instance
+func DefaultNFTConfig() *NFTConfig {
+	return NewNFTConfig(nil, 5, 3)
+}
+
+// GetCollections returns the collections whose tokens metadata should be resolved
+func (n *NFTConfig) GetCollections() []NFTCollectionConfig {
+	return n.Collections
+}
+
+// GetMetadataWorkers returns the maximum number of metadata scripts that can be executed at the same time
+func (n *NFTConfig) GetMetadataWorkers() int {
+	if n.MetadataWorkers <= 0 {
+		return DefaultNFTConfig().MetadataWorkers
+	}
+	return n.MetadataWorkers
+}
+
+// GetMetadataRetries returns the maximum number of times the metadata of a token is resolved before giving up
+func (n *NFTConfig) GetMetadataRetries() int {
+	if n.MetadataRetries <= 0 {
+		return DefaultNFTConfig().MetadataRetries
+	}
+	return n.MetadataRetries
+}
+
+// NFTCollectionConfig identifies a contract implementing the NonFungibleToken standard,
+// along with the public path at which accounts expose their MetadataViews.ResolverCollection
+type NFTCollectionConfig struct {
+	Name       string `toml:"name"`
+	Address    string `toml:"address"`
+	PublicPath string `toml:"public_path"`
+}
+
+// NewNFTCollectionConfig allows to build a new NFTCollectionConfig instance
+func NewNFTCollectionConfig(name string, address string, publicPath string) NFTCollectionConfig {
+	return NFTCollectionConfig{
+		Name:       name,
+		Address:    address,
+		PublicPath: publicPath,
+	}
+}
+
+// GetIdentifier returns the identifier used as events prefix by the collection contract, eg. A.0b2a3299cc857e29.TopShot
+func (c NFTCollectionConfig) GetIdentifier() string {
+	return fmt.Sprintf("A.%s.%s", strings.TrimPrefix(c.Address, "0x"), c.Name)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AuthConfig contains the configuration of the auth module
+type AuthConfig struct {
+	RefreshInterval   uint64 `toml:"refresh_interval"`
+	RefreshChunkSize  int    `toml:"refresh_chunk_size"`
+	RefreshChunkDelay uint64 `toml:"refresh_chunk_delay"`
+}
+
+// NewAuthConfig allows to build a new AuthConfig instance
+func NewAuthConfig(refreshInterval uint64, refreshChunkSize int, refreshChunkDelay uint64) *AuthConfig {
+	return &AuthConfig{
+		RefreshInterval:   refreshInterval,
+		RefreshChunkSize:  refreshChunkSize,
+		RefreshChunkDelay: refreshChunkDelay,
+	}
+}
+
+// DefaultAuthConfig returns the default AuthConfig instance
+func DefaultAuthConfig() *AuthConfig {
+	return NewAuthConfig(60, 100, 1000)
+}
+
+// GetRefreshInterval returns the number of minutes between two consecutive refreshes of all the stored accounts
+func (a *AuthConfig) GetRefreshInterval() uint64 {
+	if a.RefreshInterval == 0 {
+		return DefaultAuthConfig().RefreshInterval
+	}
+	return a.RefreshInterval
+}
+
+// GetRefreshChunkSize returns the number of accounts that are refreshed together during a refresh of all the stored accounts
+func (a *AuthConfig) GetRefreshChunkSize() int {
+	if a.RefreshChunkSize <= 0 {
+		return DefaultAuthConfig().RefreshChunkSize
+	}
+	return a.RefreshChunkSize
+}
+
+// GetRefreshChunkDelay returns the time waited after refreshing each chunk of accounts during a refresh
+// of all the stored accounts, so that the access node is not flooded with requests
+func (a *AuthConfig) GetRefreshChunkDelay() time.Duration {
+	if a.RefreshChunkDelay == 0 {
+		return time.Duration(DefaultAuthConfig().RefreshChunkDelay) * time.Millisecond
+	}
+	return time.Duration(a.RefreshChunkDelay) * time.Millisecond
+}
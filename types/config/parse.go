@@ -7,6 +7,9 @@ import (
 
 type configToml struct {
 	DatabaseConfig *DatabaseConfig `toml:"database"`
+	TokenConfig    *TokenConfig    `toml:"token"`
+	NFTConfig      *NFTConfig      `toml:"nft"`
+	AuthConfig     *AuthConfig     `toml:"auth"`
 }
 
 // ParseConfig allows to read the given file contents as a Config instance
@@ -29,6 +32,10 @@ func ParseConfig(fileContents []byte) (juno.Config, error) {
 		NewDatabaseConfig(
 			junoCfg.GetDatabaseConfig(),
 			cfg.DatabaseConfig.StoreHistoricalData,
+			cfg.DatabaseConfig.EventProjections,
 		),
+		cfg.TokenConfig,
+		cfg.NFTConfig,
+		cfg.AuthConfig,
 	), err
 }
@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -12,6 +13,7 @@ func TestParseConfig(t *testing.T) {
 	data := `
 [database]
   store_historical_data = true
+  event_projections = ["A.1654653399040a61.FlowToken.TokensDeposited"]
   host = "localhost"
   name = "juno"
   password = "password"
@@ -19,6 +21,26 @@ func TestParseConfig(t *testing.T) {
   schema = "public"
   ssl_mode = ""
   user = "user"
+
+[token]
+  supply_interval = 5
+
+  [[token.fungible_tokens]]
+    name = "FUSD"
+    address = "0x3c5959b568896393"
+    balance_path = "/public/fusdBalance"
+
+[nft]
+  metadata_workers = 10
+
+  [[nft.collections]]
+    name = "TopShot"
+    address = "0x0b2a3299cc857e29"
+    public_path = "/public/MomentCollection"
+
+[auth]
+  refresh_interval = 30
+  refresh_chunk_delay = 500
 `
 
 	cfg, err := config.ParseConfig([]byte(data))
@@ -28,4 +50,25 @@ func TestParseConfig(t *testing.T) {
 	require.True(t, ok)
 
 	require.Equal(t, true, dbConfig.ShouldStoreHistoricalData())
+	require.Equal(t, []string{"A.1654653399040a61.FlowToken.TokensDeposited"}, dbConfig.GetEventProjections())
+
+	tokenConfig := config.Cast(cfg).GetTokenConfig()
+	require.Equal(t, uint64(5), tokenConfig.GetSupplyInterval())
+	require.Equal(t, []config.FungibleTokenConfig{
+		config.NewFungibleTokenConfig("FUSD", "0x3c5959b568896393", "/public/fusdBalance"),
+	}, tokenConfig.GetFungibleTokens())
+	require.Equal(t, "A.3c5959b568896393.FUSD", tokenConfig.GetFungibleTokens()[0].GetIdentifier())
+
+	nftConfig := config.Cast(cfg).GetNFTConfig()
+	require.Equal(t, 10, nftConfig.GetMetadataWorkers())
+	require.Equal(t, config.DefaultNFTConfig().MetadataRetries, nftConfig.GetMetadataRetries())
+	require.Equal(t, []config.NFTCollectionConfig{
+		config.NewNFTCollectionConfig("TopShot", "0x0b2a3299cc857e29", "/public/MomentCollection"),
+	}, nftConfig.GetCollections())
+	require.Equal(t, "A.0b2a3299cc857e29.TopShot", nftConfig.GetCollections()[0].GetIdentifier())
+
+	authConfig := config.Cast(cfg).GetAuthConfig()
+	require.Equal(t, uint64(30), authConfig.GetRefreshInterval())
+	require.Equal(t, config.DefaultAuthConfig().RefreshChunkSize, authConfig.GetRefreshChunkSize())
+	require.Equal(t, 500*time.Millisecond, authConfig.GetRefreshChunkDelay())
 }
@@ -27,6 +27,10 @@ func CreateConfig(cmd *cobra.Command) juno.Config {
 		NewDatabaseConfig(
 			junoCfg.GetDatabaseConfig(),
 			storeHistoricData,
+			nil,
 		),
+		DefaultTokenConfig(),
+		DefaultNFTConfig(),
+		DefaultAuthConfig(),
 	)
 }
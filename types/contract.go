@@ -0,0 +1,55 @@
+package types
+
+const (
+	ContractActionAdded   = "added"
+	ContractActionUpdated = "updated"
+	ContractActionRemoved = "removed"
+)
+
+// ContractVersion represents a version of a contract deployed on an account.
+// Source is empty when the contract has been removed, or when the source
+// of the version could not be retrieved
+type ContractVersion struct {
+	Address       string
+	Name          string
+	Action        string
+	CodeHash      string
+	Source        string
+	TransactionID string
+	Height        uint64
+	EventIndex    int
+}
+
+// NewContractVersion allows to build a new ContractVersion
+func NewContractVersion(
+	address string,
+	name string,
+	action string,
+	codeHash string,
+	source string,
+	transactionID string,
+	height uint64,
+	eventIndex int) ContractVersion {
+	return ContractVersion{
+		Address:       address,
+		Name:          name,
+		Action:        action,
+		CodeHash:      codeHash,
+		Source:        source,
+		TransactionID: transactionID,
+		Height:        height,
+		EventIndex:    eventIndex,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v ContractVersion) Equal(w ContractVersion) bool {
+	return v.Address == w.Address &&
+		v.Name == w.Name &&
+		v.Action == w.Action &&
+		v.CodeHash == w.CodeHash &&
+		v.Source == w.Source &&
+		v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.EventIndex == w.EventIndex
+}
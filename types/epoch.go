@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"time"
+)
+
+const (
+	EpochPhaseStaking   = "staking"
+	EpochPhaseSetup     = "setup"
+	EpochPhaseCommitted = "committed"
+)
+
+// EpochPhase represents the beginning of a phase of an epoch
+type EpochPhase struct {
+	Counter   uint64
+	Phase     string
+	Height    uint64
+	Timestamp time.Time
+}
+
+// NewEpochPhase allows to build a new EpochPhase
+func NewEpochPhase(counter uint64, phase string, height uint64, timestamp time.Time) EpochPhase {
+	return EpochPhase{
+		Counter:   counter,
+		Phase:     phase,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v EpochPhase) Equal(w EpochPhase) bool {
+	return v.Counter == w.Counter &&
+		v.Phase == w.Phase &&
+		v.Height == w.Height &&
+		v.Timestamp.Equal(w.Timestamp)
+}
+
+// EpochSetup represents the payload of the EpochSetup service event of an epoch.
+// Nodes contains the JSON encoded identity table of the epoch, while Payload
+// contains the JSON encoded remaining fields of the event
+type EpochSetup struct {
+	Counter      uint64
+	FirstView    uint64
+	FinalView    uint64
+	RandomSource string
+	Nodes        []byte
+	Payload      []byte
+}
+
+// NewEpochSetup allows to build a new EpochSetup
+func NewEpochSetup(
+	counter uint64,
+	firstView uint64,
+	finalView uint64,
+	randomSource string,
+	nodes []byte,
+	payload []byte) EpochSetup {
+	return EpochSetup{
+		Counter:      counter,
+		FirstView:    firstView,
+		FinalView:    finalView,
+		RandomSource: randomSource,
+		Nodes:        nodes,
+		Payload:      payload,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v EpochSetup) Equal(w EpochSetup) bool {
+	return v.Counter == w.Counter &&
+		v.FirstView == w.FirstView &&
+		v.FinalView == w.FinalView &&
+		v.RandomSource == w.RandomSource &&
+		bytes.Equal(v.Nodes, w.Nodes) &&
+		bytes.Equal(v.Payload, w.Payload)
+}
+
+// EpochCommit represents the payload of the EpochCommit service event of an epoch,
+// containing the JSON encoded cluster quorum certificates and DKG keys
+type EpochCommit struct {
+	Counter uint64
+	Payload []byte
+}
+
+// NewEpochCommit allows to build a new EpochCommit
+func NewEpochCommit(counter uint64, payload []byte) EpochCommit {
+	return EpochCommit{
+		Counter: counter,
+		Payload: payload,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v EpochCommit) Equal(w EpochCommit) bool {
+	return v.Counter == w.Counter &&
+		bytes.Equal(v.Payload, w.Payload)
+}
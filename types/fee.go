@@ -0,0 +1,40 @@
+package types
+
+// TransactionFee represents the fees paid by the payer of a transaction.
+// The inclusion and execution efforts are zero when not reported by the FlowFees contract
+type TransactionFee struct {
+	TransactionID   string
+	Height          uint64
+	Payer           string
+	Amount          uint64
+	InclusionEffort uint64
+	ExecutionEffort uint64
+}
+
+// NewTransactionFee allows to build a new TransactionFee
+func NewTransactionFee(
+	transactionID string,
+	height uint64,
+	payer string,
+	amount uint64,
+	inclusionEffort uint64,
+	executionEffort uint64) TransactionFee {
+	return TransactionFee{
+		TransactionID:   transactionID,
+		Height:          height,
+		Payer:           payer,
+		Amount:          amount,
+		InclusionEffort: inclusionEffort,
+		ExecutionEffort: executionEffort,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TransactionFee) Equal(w TransactionFee) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Payer == w.Payer &&
+		v.Amount == w.Amount &&
+		v.InclusionEffort == w.InclusionEffort &&
+		v.ExecutionEffort == w.ExecutionEffort
+}
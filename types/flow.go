@@ -1,9 +1,14 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"reflect"
+	"strings"
 
 	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-go-sdk"
 )
 
@@ -24,6 +29,9 @@ type Tx struct {
 	Authorizers        []string
 	PayloadSignatures  []byte
 	EnvelopeSignatures []byte
+
+	// Events emitted by the transaction, set once they have been fetched
+	Events []Event
 }
 
 func NewTx(height uint64, transactionID string,
@@ -47,6 +55,50 @@ func NewTx(height uint64, transactionID string,
 	}
 }
 
+// ScriptHash returns the hex encoded SHA-256 hash of the transaction script,
+// which is used to store each distinct script only once
+func (tx Tx) ScriptHash() string {
+	hash := sha256.Sum256(tx.Script)
+	return hex.EncodeToString(hash[:])
+}
+
+// DecodedArguments returns the transaction arguments decoded from their JSON-CDC encoding
+func (tx Tx) DecodedArguments() ([]cadence.Value, error) {
+	arguments := make([]cadence.Value, len(tx.Arguments))
+	for i, argument := range tx.Arguments {
+		value, err := jsoncdc.Decode(argument)
+		if err != nil {
+			return nil, err
+		}
+		arguments[i] = value
+	}
+	return arguments, nil
+}
+
+// ArgumentsJSON returns the transaction arguments as a JSON array of JSON-CDC values.
+// Arguments that cannot be decoded are stored as plain JSON strings instead.
+func (tx Tx) ArgumentsJSON() ([]byte, error) {
+	arguments := make([]json.RawMessage, len(tx.Arguments))
+	for i, argument := range tx.Arguments {
+		value, err := jsoncdc.Decode(argument)
+		if err != nil {
+			raw, err := json.Marshal(string(argument))
+			if err != nil {
+				return nil, err
+			}
+			arguments[i] = raw
+			continue
+		}
+
+		encoded, err := jsoncdc.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		arguments[i] = encoded
+	}
+	return json.Marshal(arguments)
+}
+
 type Event struct {
 	//Transaction Result Event
 	Height           int
@@ -71,6 +123,40 @@ func NewEvent(height int, t string, transactionID string, transactionIndex int,
 	}
 }
 
+// JSON returns the JSON-Cadence (JSON-CDC) encoding of the event value
+func (e Event) JSON() ([]byte, error) {
+	return jsoncdc.Encode(e.Value)
+}
+
+// Name returns the name of the event without the address and name of the contract emitting it,
+// eg. A.1654653399040a61.FlowToken.TokensDeposited becomes TokensDeposited
+func (e Event) Name() string {
+	return e.Type[strings.LastIndex(e.Type, ".")+1:]
+}
+
+// Field returns the value of the event field having the given name, if any
+func (e Event) Field(name string) (cadence.Value, bool) {
+	if e.Value.EventType == nil {
+		return nil, false
+	}
+
+	for i, field := range e.Value.EventType.Fields {
+		if field.Identifier == name && i < len(e.Value.Fields) {
+			return e.Value.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// FieldsJSON returns the event fields flattened into a single level JSON object,
+// so that they can be stored inside a JSONB column and indexed
+func (e Event) FieldsJSON() ([]byte, error) {
+	if e.Value.EventType == nil {
+		return json.Marshal(map[string]interface{}{})
+	}
+	return json.Marshal(FlattenCadenceFields(e.Value))
+}
+
 // Successful tells whether this tx is successful or not
 func (tx Tx) Successful() bool {
 	return true
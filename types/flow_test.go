@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTx_ScriptHash(t *testing.T) {
+	first := Tx{Script: []byte("transaction {}")}
+	second := Tx{Script: []byte("transaction {}")}
+	other := Tx{Script: []byte("transaction { execute {} }")}
+
+	require.Equal(t, first.ScriptHash(), second.ScriptHash())
+	require.NotEqual(t, first.ScriptHash(), other.ScriptHash())
+}
+
+func TestTx_ArgumentsJSON(t *testing.T) {
+	tx := Tx{
+		Arguments: [][]byte{
+			[]byte(`{"type":"UFix64","value":"1000.00000000"}`),
+			[]byte(`{"type":"Address","value":"0xf233dcee88fe0abe"}`),
+		},
+	}
+
+	arguments, err := tx.DecodedArguments()
+	require.NoError(t, err)
+	require.Len(t, arguments, 2)
+
+	bz, err := tx.ArgumentsJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"type":"UFix64","value":"1000.00000000"},
+		{"type":"Address","value":"0xf233dcee88fe0abe"}
+	]`, string(bz))
+}
@@ -0,0 +1,49 @@
+package types
+
+// LockedTokensEvent represents an event emitted by the LockedTokens contract.
+// Address is the unlocked account address for UnlockedAccountRegistered events, and the locked account
+// address otherwise. NodeID is empty, and Amount and NewLimit are nil, when the event does not contain them
+type LockedTokensEvent struct {
+	TransactionID string
+	EventIndex    int
+	Height        uint64
+	Type          string
+	Address       string
+	NodeID        string
+	Amount        *uint64
+	NewLimit      *uint64
+}
+
+// NewLockedTokensEvent allows to build a new LockedTokensEvent
+func NewLockedTokensEvent(
+	transactionID string,
+	eventIndex int,
+	height uint64,
+	eventType string,
+	address string,
+	nodeID string,
+	amount *uint64,
+	newLimit *uint64) LockedTokensEvent {
+	return LockedTokensEvent{
+		TransactionID: transactionID,
+		EventIndex:    eventIndex,
+		Height:        height,
+		Type:          eventType,
+		Address:       address,
+		NodeID:        nodeID,
+		Amount:        amount,
+		NewLimit:      newLimit,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v LockedTokensEvent) Equal(w LockedTokensEvent) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.EventIndex == w.EventIndex &&
+		v.Height == w.Height &&
+		v.Type == w.Type &&
+		v.Address == w.Address &&
+		v.NodeID == w.NodeID &&
+		((v.Amount == nil && w.Amount == nil) || (v.Amount != nil && w.Amount != nil && *v.Amount == *w.Amount)) &&
+		((v.NewLimit == nil && w.NewLimit == nil) || (v.NewLimit != nil && w.NewLimit != nil && *v.NewLimit == *w.NewLimit))
+}
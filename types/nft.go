@@ -0,0 +1,123 @@
+package types
+
+// NFTTransfer represents a movement of a non fungible token between two accounts.
+// From is empty when the token has been minted, To is empty when the token has not
+// been deposited into any account (eg. it has been burned or escrowed by a contract)
+type NFTTransfer struct {
+	TransactionID string
+	Height        uint64
+	Index         int
+	Contract      string
+	TokenID       uint64
+	From          string
+	To            string
+}
+
+// NewNFTTransfer allows to build a new NFTTransfer
+func NewNFTTransfer(
+	transactionID string,
+	height uint64,
+	index int,
+	contract string,
+	tokenID uint64,
+	from string,
+	to string) NFTTransfer {
+	return NFTTransfer{
+		TransactionID: transactionID,
+		Height:        height,
+		Index:         index,
+		Contract:      contract,
+		TokenID:       tokenID,
+		From:          from,
+		To:            to,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v NFTTransfer) Equal(w NFTTransfer) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Index == w.Index &&
+		v.Contract == w.Contract &&
+		v.TokenID == w.TokenID &&
+		v.From == w.From &&
+		v.To == w.To
+}
+
+// NFTOwner represents the account owning a non fungible token starting from the given height.
+// Owner is empty when the token is not held by any account
+type NFTOwner struct {
+	Contract string
+	TokenID  uint64
+	Owner    string
+	Height   uint64
+}
+
+// NewNFTOwner allows to build a new NFTOwner
+func NewNFTOwner(contract string, tokenID uint64, owner string, height uint64) NFTOwner {
+	return NFTOwner{
+		Contract: contract,
+		TokenID:  tokenID,
+		Owner:    owner,
+		Height:   height,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v NFTOwner) Equal(w NFTOwner) bool {
+	return v.Contract == w.Contract &&
+		v.TokenID == w.TokenID &&
+		v.Owner == w.Owner &&
+		v.Height == w.Height
+}
+
+// NFTMetadata represents the metadata of a non fungible token resolved through the MetadataViews standard.
+// Error contains the reason why the last resolution failed, and is empty if the metadata has been resolved
+type NFTMetadata struct {
+	Contract    string
+	TokenID     uint64
+	Owner       string
+	Name        string
+	Description string
+	Thumbnail   string
+	ExternalURL string
+	Height      uint64
+	Attempts    int
+	Error       string
+}
+
+// NewNFTMetadata allows to build a new resolved NFTMetadata
+func NewNFTMetadata(
+	contract string,
+	tokenID uint64,
+	owner string,
+	name string,
+	description string,
+	thumbnail string,
+	externalURL string,
+	height uint64,
+	attempts int) NFTMetadata {
+	return NFTMetadata{
+		Contract:    contract,
+		TokenID:     tokenID,
+		Owner:       owner,
+		Name:        name,
+		Description: description,
+		Thumbnail:   thumbnail,
+		ExternalURL: externalURL,
+		Height:      height,
+		Attempts:    attempts,
+	}
+}
+
+// NewFailedNFTMetadata allows to build a new NFTMetadata whose resolution failed with the given error
+func NewFailedNFTMetadata(contract string, tokenID uint64, owner string, height uint64, attempts int, err error) NFTMetadata {
+	return NFTMetadata{
+		Contract: contract,
+		TokenID:  tokenID,
+		Owner:    owner,
+		Height:   height,
+		Attempts: attempts,
+		Error:    err.Error(),
+	}
+}
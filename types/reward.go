@@ -0,0 +1,139 @@
+package types
+
+import "time"
+
+// EpochRange represents the heights and the duration of an epoch that has ended.
+// Duration is zero when the epoch start or end time is not known
+type EpochRange struct {
+	Counter     uint64
+	StartHeight uint64
+	EndHeight   uint64
+	Duration    time.Duration
+}
+
+// NewEpochRange allows to build a new EpochRange
+func NewEpochRange(counter uint64, startHeight uint64, endHeight uint64, duration time.Duration) EpochRange {
+	return EpochRange{
+		Counter:     counter,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Duration:    duration,
+	}
+}
+
+// NodeStake represents the tokens staked by a node operator during an epoch
+type NodeStake struct {
+	NodeID       string
+	Role         uint8
+	TokensStaked uint64
+}
+
+// NewNodeStake allows to build a new NodeStake
+func NewNodeStake(nodeID string, role uint8, tokensStaked uint64) NodeStake {
+	return NodeStake{
+		NodeID:       nodeID,
+		Role:         role,
+		TokensStaked: tokensStaked,
+	}
+}
+
+// DelegatorStake represents the tokens staked by a delegator during an epoch
+type DelegatorStake struct {
+	NodeID       string
+	DelegatorID  uint32
+	TokensStaked uint64
+}
+
+// NewDelegatorStake allows to build a new DelegatorStake
+func NewDelegatorStake(nodeID string, delegatorID uint32, tokensStaked uint64) DelegatorStake {
+	return DelegatorStake{
+		NodeID:       nodeID,
+		DelegatorID:  delegatorID,
+		TokensStaked: tokensStaked,
+	}
+}
+
+// PaidReward represents the amount of a RewardsPaid or DelegatorRewardsPaid event.
+// DelegatorID is nil when the rewards have been paid to the node operator
+type PaidReward struct {
+	NodeID      string
+	DelegatorID *uint32
+	Amount      uint64
+}
+
+// NewPaidReward allows to build a new PaidReward
+func NewPaidReward(nodeID string, delegatorID *uint32, amount uint64) PaidReward {
+	return PaidReward{
+		NodeID:      nodeID,
+		DelegatorID: delegatorID,
+		Amount:      amount,
+	}
+}
+
+// NodeEpochReward represents the rewards earned by a node operator during an epoch,
+// including the cut taken from the rewards of its delegators.
+// PaidReward is nil when no RewardsPaid event has been found for the node
+type NodeEpochReward struct {
+	Epoch          uint64
+	NodeID         string
+	TokensStaked   uint64
+	ComputedReward uint64
+	PaidReward     *uint64
+	Reconciled     bool
+	APY            float64
+}
+
+// NewNodeEpochReward allows to build a new NodeEpochReward
+func NewNodeEpochReward(
+	epoch uint64,
+	nodeID string,
+	tokensStaked uint64,
+	computedReward uint64,
+	paidReward *uint64,
+	reconciled bool,
+	apy float64) NodeEpochReward {
+	return NodeEpochReward{
+		Epoch:          epoch,
+		NodeID:         nodeID,
+		TokensStaked:   tokensStaked,
+		ComputedReward: computedReward,
+		PaidReward:     paidReward,
+		Reconciled:     reconciled,
+		APY:            apy,
+	}
+}
+
+// DelegatorEpochReward represents the rewards earned by a delegator during an epoch, net of the node operator cut.
+// PaidReward is nil when no DelegatorRewardsPaid event has been found for the delegator
+type DelegatorEpochReward struct {
+	Epoch          uint64
+	NodeID         string
+	DelegatorID    uint32
+	TokensStaked   uint64
+	ComputedReward uint64
+	PaidReward     *uint64
+	Reconciled     bool
+	APY            float64
+}
+
+// NewDelegatorEpochReward allows to build a new DelegatorEpochReward
+func NewDelegatorEpochReward(
+	epoch uint64,
+	nodeID string,
+	delegatorID uint32,
+	tokensStaked uint64,
+	computedReward uint64,
+	paidReward *uint64,
+	reconciled bool,
+	apy float64) DelegatorEpochReward {
+	return DelegatorEpochReward{
+		Epoch:          epoch,
+		NodeID:         nodeID,
+		DelegatorID:    delegatorID,
+		TokensStaked:   tokensStaked,
+		ComputedReward: computedReward,
+		PaidReward:     paidReward,
+		Reconciled:     reconciled,
+		APY:            apy,
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import "bytes"
+
+// StakingEvent represents an event emitted by the FlowIDTableStaking contract.
+// NodeID is empty, and DelegatorID and Amount are nil, when the event does not refer to them.
+// Fields contains all the fields of the event, encoded as a flat JSON object
+type StakingEvent struct {
+	TransactionID string
+	EventIndex    int
+	Height        uint64
+	Type          string
+	NodeID        string
+	DelegatorID   *uint32
+	Amount        *uint64
+	Fields        []byte
+}
+
+// NewStakingEvent allows to build a new StakingEvent
+func NewStakingEvent(
+	transactionID string,
+	eventIndex int,
+	height uint64,
+	eventType string,
+	nodeID string,
+	delegatorID *uint32,
+	amount *uint64,
+	fields []byte) StakingEvent {
+	return StakingEvent{
+		TransactionID: transactionID,
+		EventIndex:    eventIndex,
+		Height:        height,
+		Type:          eventType,
+		NodeID:        nodeID,
+		DelegatorID:   delegatorID,
+		Amount:        amount,
+		Fields:        fields,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v StakingEvent) Equal(w StakingEvent) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.EventIndex == w.EventIndex &&
+		v.Height == w.Height &&
+		v.Type == w.Type &&
+		v.NodeID == w.NodeID &&
+		((v.DelegatorID == nil && w.DelegatorID == nil) ||
+			(v.DelegatorID != nil && w.DelegatorID != nil && *v.DelegatorID == *w.DelegatorID)) &&
+		((v.Amount == nil && w.Amount == nil) ||
+			(v.Amount != nil && w.Amount != nil && *v.Amount == *w.Amount)) &&
+		bytes.Equal(v.Fields, w.Fields)
+}
@@ -0,0 +1,88 @@
+// Package testutil contains the fixtures shared by the tests of the different modules
+package testutil
+
+import (
+	"regexp"
+
+	"github.com/onflow/cadence"
+
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// TransactionID is the id of the transaction emitting the events built by NewEvent
+const TransactionID = "0x6"
+
+// addressPrefixRegExp matches the address prefix of the types of the events emitted by deployed contracts
+var addressPrefixRegExp = regexp.MustCompile(`^A\.[0-9a-f]+\.`)
+
+// EventField represents a single field of a cadence event, along with its value
+type EventField struct {
+	Identifier string
+	Type       cadence.Type
+	Value      cadence.Value
+}
+
+// NewEventField allows to build a new EventField instance
+func NewEventField(identifier string, fieldType cadence.Type, value cadence.Value) EventField {
+	return EventField{
+		Identifier: identifier,
+		Type:       fieldType,
+		Value:      value,
+	}
+}
+
+// AddressField returns a field containing the given address
+func AddressField(identifier string, address []byte) EventField {
+	return NewEventField(identifier, cadence.AddressType{}, cadence.BytesToAddress(address))
+}
+
+// OptionalAddressField returns a field containing the given address, or nil if no address is given
+func OptionalAddressField(identifier string, address []byte) EventField {
+	var value cadence.Value
+	if address != nil {
+		value = cadence.BytesToAddress(address)
+	}
+	return NewEventField(identifier, cadence.OptionalType{Type: cadence.AddressType{}}, cadence.NewOptional(value))
+}
+
+// BytesField returns a field containing the given bytes as a [UInt8] array
+func BytesField(identifier string, bytes []byte) EventField {
+	values := make([]cadence.Value, len(bytes))
+	for i, b := range bytes {
+		values[i] = cadence.UInt8(b)
+	}
+	return NewEventField(identifier, cadence.VariableSizedArrayType{ElementType: cadence.UInt8Type{}},
+		cadence.NewArray(values))
+}
+
+// StringField returns a field containing the given string
+func StringField(identifier string, value string) EventField {
+	return NewEventField(identifier, cadence.StringType{}, cadence.NewString(value))
+}
+
+// UInt64Field returns a field containing the given unsigned integer
+func UInt64Field(identifier string, value uint64) EventField {
+	return NewEventField(identifier, cadence.UInt64Type{}, cadence.NewUInt64(value))
+}
+
+// UFix64Field returns a field containing the given fixed point number, expressed in its smallest unit
+func UFix64Field(identifier string, value uint64) EventField {
+	return NewEventField(identifier, cadence.UFix64Type{}, cadence.UFix64(value))
+}
+
+// NewEvent returns the event having the given type and fields, emitted by the first transaction of the block
+// at the given height at the given event index
+func NewEvent(height int, eventType string, eventIndex int, fields ...EventField) types.Event {
+	valueType := &cadence.EventType{
+		QualifiedIdentifier: addressPrefixRegExp.ReplaceAllString(eventType, ""),
+		Fields:              make([]cadence.Field, len(fields)),
+	}
+
+	values := make([]cadence.Value, len(fields))
+	for i, field := range fields {
+		valueType.Fields[i] = cadence.Field{Identifier: field.Identifier, Type: field.Type}
+		values[i] = field.Value
+	}
+
+	return types.NewEvent(height, eventType, TransactionID, 0, eventIndex, cadence.NewEvent(values).WithType(valueType))
+}
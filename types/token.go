@@ -0,0 +1,71 @@
+package types
+
+// TokenTransfer represents a movement of fungible tokens between two accounts.
+// From is empty when the tokens have been minted, To is empty when the tokens have not
+// been deposited into any account (eg. they have been burned)
+type TokenTransfer struct {
+	TransactionID string
+	Height        uint64
+	Index         int
+	Token         string
+	From          string
+	To            string
+	Amount        uint64
+}
+
+// NewTokenTransfer allows to build a new TokenTransfer
+func NewTokenTransfer(
+	transactionID string,
+	height uint64,
+	index int,
+	token string,
+	from string,
+	to string,
+	amount uint64) TokenTransfer {
+	return TokenTransfer{
+		TransactionID: transactionID,
+		Height:        height,
+		Index:         index,
+		Token:         token,
+		From:          from,
+		To:            to,
+		Amount:        amount,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v TokenTransfer) Equal(w TokenTransfer) bool {
+	return v.TransactionID == w.TransactionID &&
+		v.Height == w.Height &&
+		v.Index == w.Index &&
+		v.Token == w.Token &&
+		v.From == w.From &&
+		v.To == w.To &&
+		v.Amount == w.Amount
+}
+
+// AccountTokenBalance represents the balance of a fungible token held by an account at a given height
+type AccountTokenBalance struct {
+	Address string
+	Token   string
+	Balance uint64
+	Height  uint64
+}
+
+// NewAccountTokenBalance allows to build a new AccountTokenBalance
+func NewAccountTokenBalance(address string, token string, balance uint64, height uint64) AccountTokenBalance {
+	return AccountTokenBalance{
+		Address: address,
+		Token:   token,
+		Balance: balance,
+		Height:  height,
+	}
+}
+
+// Equal tells whether v and w represent the same rows
+func (v AccountTokenBalance) Equal(w AccountTokenBalance) bool {
+	return v.Address == w.Address &&
+		v.Token == w.Token &&
+		v.Balance == w.Balance &&
+		v.Height == w.Height
+}
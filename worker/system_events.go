@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/HarleyAppleChoi/junomum/logging"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+// eventsFetcher returns the events having one of the given types emitted inside the block at the given height
+type eventsFetcher func(eventTypes []string, height int64) ([]types.Event, error)
+
+// handleSystemEvents fetches at once the events of all the types handled by the given modules that have been emitted
+// inside the given block, and gives each modules.SystemEventsModule the ones having the types it handles
+func handleSystemEvents(
+	block *flow.Block, mods []modules.Module, fetchEvents eventsFetcher, logger logging.Logger,
+) error {
+	var eventTypes []string
+	fetched := make(map[string]bool)
+	for _, module := range mods {
+		if systemEventsModule, ok := module.(modules.SystemEventsModule); ok {
+			for _, eventType := range systemEventsModule.SystemEventTypes() {
+				if !fetched[eventType] {
+					fetched[eventType] = true
+					eventTypes = append(eventTypes, eventType)
+				}
+			}
+		}
+	}
+
+	if len(eventTypes) == 0 {
+		return nil
+	}
+
+	events, err := fetchEvents(eventTypes, int64(block.Height))
+	if err != nil {
+		return err
+	}
+
+	for _, module := range mods {
+		systemEventsModule, ok := module.(modules.SystemEventsModule)
+		if !ok {
+			continue
+		}
+
+		moduleEvents := filterEventsByType(events, systemEventsModule.SystemEventTypes())
+		if len(moduleEvents) == 0 {
+			continue
+		}
+
+		err = systemEventsModule.HandleSystemEvents(block, moduleEvents)
+		if err != nil {
+			logger.BlockError(module, block, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterEventsByType returns the given events having one of the given types, keeping their order
+func filterEventsByType(events []types.Event, eventTypes []string) []types.Event {
+	accepted := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		accepted[eventType] = true
+	}
+
+	var filtered []types.Event
+	for _, event := range events {
+		if accepted[event.Type] {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
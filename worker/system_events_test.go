@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HarleyAppleChoi/junomum/logging"
+	"github.com/HarleyAppleChoi/junomum/modules/modules"
+	"github.com/HarleyAppleChoi/junomum/types"
+)
+
+const (
+	tokensMintedType = "A.1654653399040a61.FlowToken.TokensMinted"
+	epochSetupType   = "A.8624b52f9ddcd04a.FlowEpoch.EpochSetup"
+)
+
+// systemEventsModule is a modules.SystemEventsModule that records the events it is given
+type systemEventsModule struct {
+	name       string
+	eventTypes []string
+	handled    [][]types.Event
+	err        error
+}
+
+func (m *systemEventsModule) Name() string {
+	return m.name
+}
+
+func (m *systemEventsModule) SystemEventTypes() []string {
+	return m.eventTypes
+}
+
+func (m *systemEventsModule) HandleSystemEvents(_ *flow.Block, events []types.Event) error {
+	m.handled = append(m.handled, events)
+	return m.err
+}
+
+// plainModule is a modules.Module that does not handle system events
+type plainModule struct{}
+
+func (plainModule) Name() string {
+	return "plain"
+}
+
+func TestHandleSystemEvents(t *testing.T) {
+	// The block has no collections: the mint of the epoch rewards is only emitted by the system chunk
+	block := &flow.Block{BlockHeader: flow.BlockHeader{Height: 10}}
+	systemChunkID := "a3f0b1c7e9b54c2d8f3e0c4b6a9d1e2f7c8b5a4d3e2f1a0b9c8d7e6f5a4b3c2d"
+	minted := types.NewEvent(10, tokensMintedType, systemChunkID, 0, 1, cadence.Event{})
+	setup := types.NewEvent(10, epochSetupType, systemChunkID, 0, 0, cadence.Event{})
+
+	token := &systemEventsModule{name: "token", eventTypes: []string{tokensMintedType}}
+	epoch := &systemEventsModule{name: "epoch", eventTypes: []string{epochSetupType, tokensMintedType}}
+
+	var fetches [][]string
+	fetch := func(eventTypes []string, height int64) ([]types.Event, error) {
+		require.Equal(t, int64(10), height)
+		fetches = append(fetches, eventTypes)
+		return []types.Event{setup, minted}, nil
+	}
+
+	err := handleSystemEvents(block, []modules.Module{plainModule{}, token, epoch}, fetch, logging.DefaultLogger())
+	require.NoError(t, err)
+
+	// The events should be fetched once, without repeating the types shared by the modules
+	require.Equal(t, [][]string{{tokensMintedType, epochSetupType}}, fetches)
+	require.Equal(t, [][]types.Event{{minted}}, token.handled)
+	require.Equal(t, [][]types.Event{{setup, minted}}, epoch.handled)
+}
+
+func TestHandleSystemEvents_NoEvents(t *testing.T) {
+	block := &flow.Block{BlockHeader: flow.BlockHeader{Height: 10}}
+	token := &systemEventsModule{name: "token", eventTypes: []string{tokensMintedType}}
+
+	fetched := false
+	fetch := func(eventTypes []string, height int64) ([]types.Event, error) {
+		fetched = true
+		return nil, nil
+	}
+
+	// Modules should not be called when none of their events have been emitted
+	err := handleSystemEvents(block, []modules.Module{token}, fetch, logging.DefaultLogger())
+	require.NoError(t, err)
+	require.True(t, fetched)
+	require.Empty(t, token.handled)
+
+	// Nothing should be fetched when no module handles system events
+	fetched = false
+	err = handleSystemEvents(block, []modules.Module{plainModule{}}, fetch, logging.DefaultLogger())
+	require.NoError(t, err)
+	require.False(t, fetched)
+}
+
+func TestHandleSystemEvents_Errors(t *testing.T) {
+	block := &flow.Block{BlockHeader: flow.BlockHeader{Height: 10}}
+	minted := types.NewEvent(10, tokensMintedType, "", 0, 0, cadence.Event{})
+
+	token := &systemEventsModule{name: "token", eventTypes: []string{tokensMintedType}}
+	err := handleSystemEvents(block, []modules.Module{token}, func([]string, int64) ([]types.Event, error) {
+		return nil, fmt.Errorf("access node unavailable")
+	}, logging.DefaultLogger())
+	require.Error(t, err)
+	require.Empty(t, token.handled)
+
+	token.err = fmt.Errorf("cannot get supply")
+	err = handleSystemEvents(block, []modules.Module{token}, func([]string, int64) ([]types.Event, error) {
+		return []types.Event{minted}, nil
+	}, logging.DefaultLogger())
+	require.Error(t, err)
+}
@@ -126,6 +126,23 @@ func (w Worker) process(height int64) error {
 		return err
 	}
 
+	// Call the parsed block handlers, now that the transactions contain their events
+	for _, module := range w.modules {
+		if parsedBlockModule, ok := module.(modules.ParsedBlockModule); ok {
+			err = parsedBlockModule.HandleParsedBlock(block, txs)
+			if err != nil {
+				w.logger.BlockError(module, block, err)
+				return err
+			}
+		}
+	}
+
+	// Call the system events handlers, fetching the events they need once for all of them
+	err = handleSystemEvents(block, w.modules, w.cp.EventsOfTypes, w.logger)
+	if err != nil {
+		return err
+	}
+
 	if len(collections) == 0 {
 		return nil
 	}
@@ -230,19 +247,22 @@ func (w Worker) ExportTx(txs *types.Txs) error {
 
 	//Handle all event
 	var allEventInTx []types.Event
-	for _, tx := range *txs {
+	var accountTransactions []types.AccountTransaction
+	for i, tx := range *txs {
 		events, err := w.cp.EventsInTransaction(tx)
 		if err != nil {
 			log.Error().Err(err).Int64("height", int64(tx.Height)).Msg("failed to get events for block")
 			return err
 		}
 		allEventInTx = append(allEventInTx, events...)
+		(*txs)[i].Events = events
+		accountTransactions = append(accountTransactions, types.NewAccountTransactions(tx, events)...)
 
 		//Handle event with associated tx
 		for _, event := range events {
 			for _, module := range w.modules {
 				if messageModule, ok := module.(modules.MessageModule); ok {
-					err = messageModule.HandleEvent(event.Height, event, &tx)
+					err = messageModule.HandleEvent(event.Height, event, &(*txs)[i])
 					if err != nil {
 						w.logger.EventsError(module, &event, err)
 						return err
@@ -257,6 +277,12 @@ func (w Worker) ExportTx(txs *types.Txs) error {
 		return err
 	}
 
+	err = w.db.SaveAccountTransactions(accountTransactions)
+	if err != nil {
+		log.Error().Err(err).Int64("height", int64((*txs)[0].Height)).Msg("failed to export account transactions")
+		return err
+	}
+
 	for _, tx := range *txs {
 		for _, module := range w.modules {
 			if transactionModule, ok := module.(modules.TransactionModule); ok {